@@ -0,0 +1,107 @@
+package archive
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// memberHeaderSize is the size of an archive member header.
+const memberHeaderSize = 60
+
+// MemberKind identifies the kind of data held by an archive member.
+type MemberKind int
+
+// Kinds of archive members.
+const (
+	MemberUnknown   MemberKind = iota // The member holds unrecognized data
+	MemberObject                      // The member is a COFF object file
+	MemberImport                      // The member is a short import object
+	MemberAnonymous                   // The member is an anonymous object, such as an object for link-time code generation
+)
+
+// String returns a string representation of the member kind.
+func (kind MemberKind) String() string {
+	switch kind {
+	case MemberUnknown:
+		return "Unknown"
+	case MemberObject:
+		return "Object"
+	case MemberImport:
+		return "Import"
+	case MemberAnonymous:
+		return "Anonymous"
+	default:
+		return fmt.Sprintf("<unrecognized member kind: %d>", int(kind))
+	}
+}
+
+// Member is a file within an archive.
+type Member struct {
+	// Name is the name of the member. Long names are resolved from the
+	// long names member.
+	Name string
+
+	// Date is the time at which the member was created. Many tools write
+	// zero to produce reproducible output.
+	Date time.Time
+
+	// Mode is the file mode of the member, as an octal string.
+	Mode string
+
+	// Header is the file offset of the member's header, which is used by
+	// the linker members to refer to it.
+	Header imagefile.FileOffset
+
+	// Location is the file range of the member's data.
+	Location imagefile.FileRange
+
+	// Kind is the kind of data held by the member.
+	Kind MemberKind
+}
+
+// memberHeader is the header that precedes each archive member.
+type memberHeader []byte
+
+// Name returns the raw name field of the header, without padding.
+func (header memberHeader) Name() string {
+	return strings.TrimRight(string(header[0:16]), " ")
+}
+
+// Date returns the modification time of the member, in seconds since the
+// Unix epoch.
+func (header memberHeader) Date() (int64, error) {
+	return parseField(header[16:28], 10, "date")
+}
+
+// Mode returns the file mode of the member, in octal.
+func (header memberHeader) Mode() string {
+	return strings.TrimRight(string(header[40:48]), " ")
+}
+
+// Size returns the size of the member's data.
+func (header memberHeader) Size() (int64, error) {
+	return parseField(header[48:58], 10, "size")
+}
+
+// Valid returns true if the header ends with the expected terminator.
+func (header memberHeader) Valid() bool {
+	return header[58] == '`' && header[59] == '\n'
+}
+
+// parseField parses a space-padded numeric field of a member header. An
+// empty field is treated as zero.
+func parseField(field []byte, base int, name string) (int64, error) {
+	value := strings.TrimRight(string(field), " ")
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, base, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("the archive member header has an invalid %s field: %q", name, value)
+	}
+	return n, nil
+}
@@ -0,0 +1,379 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gentlemanautomaton/portableexecutable/coff"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Signature is the signature at the start of an archive file.
+const Signature = "!<arch>\n"
+
+// ErrMissingSymbolTable is returned by [Reader.ReadSymbols] if the archive
+// does not have a linker member.
+var ErrMissingSymbolTable = errors.New("the archive does not have a linker member")
+
+// Names of special archive members.
+const (
+	linkerMemberName    = "/"
+	longNamesMemberName = "//"
+)
+
+// Reader reads static and import library (.lib) archive data from an
+// underlying [io.ReaderAt].
+//
+// Archives hold a sequence of members, which are usually COFF object files
+// or short import objects. They begin with special linker members that
+// index the public symbols defined by the other members, and a long names
+// member that holds member names that don't fit in member headers.
+type Reader struct {
+	source io.ReaderAt
+
+	members      []Member
+	firstLinker  *Member
+	secondLinker *Member
+	specials     []Member
+	byHeader     map[imagefile.FileOffset]int
+}
+
+// NewReader creates and initializes a new archive [Reader] that reads from
+// source.
+//
+// It reads and validates the header of each member and determines the kind
+// of data that each member holds. If the validation fails it returns an
+// error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	var signature [len(Signature)]byte
+	if _, err := source.ReadAt(signature[:], 0); err != nil {
+		return nil, fmt.Errorf("failed to read the archive signature: %w", err)
+	}
+	if string(signature[:]) != Signature {
+		return nil, fmt.Errorf("the file does not have the expected archive signature")
+	}
+
+	r := &Reader{
+		source:   source,
+		byHeader: make(map[imagefile.FileOffset]int),
+	}
+
+	var longNames []byte
+	offset := imagefile.FileOffset(len(Signature))
+	header := make(memberHeader, memberHeaderSize)
+	for {
+		n, err := source.ReadAt(header, int64(offset))
+		if n == 0 && errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the archive member header at offset %s: %w", offset, err)
+		}
+		if !header.Valid() {
+			return nil, fmt.Errorf("the archive member header at offset %s is invalid", offset)
+		}
+		size, err := header.Size()
+		if err != nil {
+			return nil, err
+		}
+		date, err := header.Date()
+		if err != nil {
+			return nil, err
+		}
+
+		member := Member{
+			Name:   header.Name(),
+			Date:   time.Unix(date, 0).UTC(),
+			Mode:   header.Mode(),
+			Header: offset,
+			Location: imagefile.FileRange{
+				Start:  offset + memberHeaderSize,
+				Length: uint(size),
+			},
+		}
+
+		// Members are aligned to 2 byte boundaries.
+		offset = member.Location.Start + imagefile.FileOffset(size)
+		offset += offset & 1
+
+		switch name := member.Name; {
+		case name == linkerMemberName && r.firstLinker == nil && len(r.members) == 0:
+			r.firstLinker = &member
+		case name == linkerMemberName && r.secondLinker == nil && len(r.members) == 0:
+			r.secondLinker = &member
+		case name == longNamesMemberName:
+			if longNames, err = r.ReadMemberData(member); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(name, "/") && isSpecialName(name):
+			// Other special members, such as the symbol tables of hybrid
+			// ARM64X archives, are recorded but not interpreted.
+			r.specials = append(r.specials, member)
+		default:
+			if member.Name, err = resolveName(name, longNames); err != nil {
+				return nil, fmt.Errorf("failed to read the name of the archive member at offset %s: %w", member.Header, err)
+			}
+			if member.Kind, err = r.memberKind(member); err != nil {
+				return nil, err
+			}
+			r.byHeader[member.Header] = len(r.members)
+			r.members = append(r.members, member)
+		}
+	}
+
+	return r, nil
+}
+
+// Members returns the members of the archive, excluding the linker
+// members, the long names member and other special members.
+func (r *Reader) Members() []Member {
+	return r.members
+}
+
+// SpecialMembers returns members with reserved names that are not
+// interpreted by the reader, such as the "/<ECSYMBOLS>/" member of hybrid
+// ARM64X archives.
+func (r *Reader) SpecialMembers() []Member {
+	return r.specials
+}
+
+// ReadMemberData reads the data of the given member.
+func (r *Reader) ReadMemberData(member Member) ([]byte, error) {
+	data := make([]byte, member.Location.Length)
+	if len(data) == 0 {
+		return data, nil
+	}
+	if _, err := r.source.ReadAt(data, int64(member.Location.Start)); err != nil {
+		return nil, fmt.Errorf("failed to read the data of archive member \"%s\" at location %s: %w", member.Name, member.Location, err)
+	}
+	return data, nil
+}
+
+// OpenObject returns a COFF object file reader for the given member, which
+// must hold a COFF object file.
+func (r *Reader) OpenObject(member Member) (*coff.Reader, error) {
+	if member.Kind != MemberObject {
+		return nil, fmt.Errorf("the archive member \"%s\" holds %s data instead of a COFF object file", member.Name, member.Kind)
+	}
+	section := io.NewSectionReader(r.source, int64(member.Location.Start), int64(member.Location.Length))
+	obj, err := coff.NewReader(section)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive member \"%s\": %w", member.Name, err)
+	}
+	return obj, nil
+}
+
+// ReadImport reads the short import object held by the given member.
+func (r *Reader) ReadImport(member Member) (coff.ImportObject, error) {
+	if member.Kind != MemberImport {
+		return coff.ImportObject{}, fmt.Errorf("the archive member \"%s\" holds %s data instead of a short import object", member.Name, member.Kind)
+	}
+	data, err := r.ReadMemberData(member)
+	if err != nil {
+		return coff.ImportObject{}, err
+	}
+	imp, err := coff.ParseImportObject(data)
+	if err != nil {
+		return coff.ImportObject{}, fmt.Errorf("failed to read archive member \"%s\": %w", member.Name, err)
+	}
+	return imp, nil
+}
+
+// ReadImports reads the short import objects held by the archive's members,
+// in the order that they appear.
+func (r *Reader) ReadImports() ([]coff.ImportObject, error) {
+	var imports []coff.ImportObject
+	for _, member := range r.members {
+		if member.Kind != MemberImport {
+			continue
+		}
+		imp, err := r.ReadImport(member)
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, imp)
+	}
+	return imports, nil
+}
+
+// Symbol is a public symbol listed by an archive's linker member, along
+// with the index of the member that defines it.
+type Symbol struct {
+	Name   string
+	Member int
+}
+
+// ReadSymbols reads the public symbols listed by the archive's linker
+// members. The second linker member, which is sorted by name, is preferred
+// when present.
+//
+// If the archive does not have a linker member it returns
+// [ErrMissingSymbolTable].
+func (r *Reader) ReadSymbols() ([]Symbol, error) {
+	switch {
+	case r.secondLinker != nil && r.firstLinker != nil:
+		return r.readSecondLinkerMember(*r.secondLinker)
+	case r.firstLinker != nil:
+		return r.readFirstLinkerMember(*r.firstLinker)
+	default:
+		return nil, ErrMissingSymbolTable
+	}
+}
+
+// readFirstLinkerMember reads the symbols of the first linker member, which
+// holds big-endian member offsets in the order of the archive.
+func (r *Reader) readFirstLinkerMember(member Member) ([]Symbol, error) {
+	data, err := r.ReadMemberData(member)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, fmt.Errorf("the first linker member is truncated")
+	}
+	count := uint(binary.BigEndian.Uint32(data[0:4]))
+	if count > uint(len(data)-4)/4 {
+		return nil, fmt.Errorf("the first linker member has %d symbols, which exceeds its size", count)
+	}
+	offsets := data[4 : 4+count*4]
+	names, err := splitNames(data[4+count*4:], count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the first linker member: %w", err)
+	}
+
+	symbols := make([]Symbol, count)
+	for i := range symbols {
+		header := imagefile.FileOffset(binary.BigEndian.Uint32(offsets[i*4:]))
+		index, ok := r.byHeader[header]
+		if !ok {
+			return nil, fmt.Errorf("the first linker member refers to a member at offset %s, which does not exist", header)
+		}
+		symbols[i] = Symbol{Name: names[i], Member: index}
+	}
+	return symbols, nil
+}
+
+// readSecondLinkerMember reads the symbols of the second linker member,
+// which holds little-endian member offsets and symbols sorted by name.
+func (r *Reader) readSecondLinkerMember(member Member) ([]Symbol, error) {
+	data, err := r.ReadMemberData(member)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 8 {
+		return nil, fmt.Errorf("the second linker member is truncated")
+	}
+	memberCount := uint(binary.LittleEndian.Uint32(data[0:4]))
+	if memberCount > uint(len(data)-8)/4 {
+		return nil, fmt.Errorf("the second linker member has %d members, which exceeds its size", memberCount)
+	}
+	offsets := data[4 : 4+memberCount*4]
+	data = data[4+memberCount*4:]
+	if len(data) < 4 {
+		return nil, fmt.Errorf("the second linker member is truncated")
+	}
+
+	count := uint(binary.LittleEndian.Uint32(data[0:4]))
+	if count > uint(len(data)-4)/2 {
+		return nil, fmt.Errorf("the second linker member has %d symbols, which exceeds its size", count)
+	}
+	indices := data[4 : 4+count*2]
+	names, err := splitNames(data[4+count*2:], count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the second linker member: %w", err)
+	}
+
+	symbols := make([]Symbol, count)
+	for i := range symbols {
+		// Member indices are 1-based.
+		index := uint(binary.LittleEndian.Uint16(indices[i*2:]))
+		if index == 0 || index > memberCount {
+			return nil, fmt.Errorf("the second linker member has an invalid member index of %d for symbol %d", index, i)
+		}
+		header := imagefile.FileOffset(binary.LittleEndian.Uint32(offsets[(index-1)*4:]))
+		member, ok := r.byHeader[header]
+		if !ok {
+			return nil, fmt.Errorf("the second linker member refers to a member at offset %s, which does not exist", header)
+		}
+		symbols[i] = Symbol{Name: names[i], Member: member}
+	}
+	return symbols, nil
+}
+
+// memberKind reads the start of the given member's data to determine the
+// kind of data that it holds.
+func (r *Reader) memberKind(member Member) (MemberKind, error) {
+	var header [imagefile.FileHeaderSize]byte
+	if member.Location.Length < uint(len(header)) {
+		return MemberUnknown, nil
+	}
+	if _, err := r.source.ReadAt(header[:], int64(member.Location.Start)); err != nil {
+		return MemberUnknown, fmt.Errorf("failed to read the data of archive member \"%s\": %w", member.Name, err)
+	}
+	if coff.IsImportObject(header[:]) {
+		return MemberImport, nil
+	}
+	fileHeader := imagefile.FileHeader(header[:])
+	if fileHeader.Machine() == imagefile.MachineUnknown && fileHeader.NumberOfSections() == 0xFFFF {
+		// Extended object files start with an anonymous object header too.
+		section := io.NewSectionReader(r.source, int64(member.Location.Start), int64(member.Location.Length))
+		if _, err := coff.NewReader(section); err == nil {
+			return MemberObject, nil
+		}
+		return MemberAnonymous, nil
+	}
+	if fileHeader.Machine().Known() && fileHeader.SizeOfOptionalHeader() == 0 {
+		return MemberObject, nil
+	}
+	return MemberUnknown, nil
+}
+
+// isSpecialName returns true if the given member name is reserved, such as
+// "/SYM64/" or "/<ECSYMBOLS>/".
+func isSpecialName(name string) bool {
+	if len(name) < 2 || !strings.HasSuffix(name, "/") {
+		return false
+	}
+	_, err := strconv.ParseUint(name[1:], 10, 32)
+	return err != nil
+}
+
+// resolveName resolves the given member name. Names in the form "/123" are
+// offsets into the long names member, where names are terminated by a null
+// byte or by "/\n". Other names are terminated by a slash.
+func resolveName(name string, longNames []byte) (string, error) {
+	if offset, ok := strings.CutPrefix(name, "/"); ok && offset != "" {
+		n, err := strconv.ParseUint(offset, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("the member name %q has an invalid long name offset", name)
+		}
+		if n >= uint64(len(longNames)) {
+			return "", fmt.Errorf("the long name offset %d exceeds the %d byte length of the long names member", n, len(longNames))
+		}
+		long := longNames[n:]
+		if cutoff := bytes.IndexAny(long, "\x00\n"); cutoff >= 0 {
+			long = long[:cutoff]
+		}
+		return strings.TrimSuffix(string(long), "/"), nil
+	}
+	return strings.TrimSuffix(name, "/"), nil
+}
+
+// splitNames splits count null-terminated names from the start of data.
+func splitNames(data []byte, count uint) ([]string, error) {
+	names := make([]string, 0, count)
+	for range count {
+		cutoff := bytes.IndexByte(data, 0)
+		if cutoff < 0 {
+			return nil, fmt.Errorf("the string table holds %d names instead of %d", len(names), count)
+		}
+		names = append(names, string(data[:cutoff]))
+		data = data[cutoff+1:]
+	}
+	return names, nil
+}
@@ -0,0 +1,354 @@
+package authenticode
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/internal/bytesconv"
+)
+
+// ErrInvalidCatalogSignature is returned by [ParseCatalog] when the
+// catalog's signature does not match its content.
+var ErrInvalidCatalogSignature = errors.New("the catalog signature is invalid")
+
+// Catalog is a security catalog, which is typically stored in a .cat file.
+//
+// A catalog is a PKCS#7 SignedData structure whose content is a
+// certificate trust list. Each member of the list records the digest of a
+// file, allowing a single signature to cover many files. Most of the
+// files that ship with Windows are signed this way rather than carrying
+// an embedded signature.
+type Catalog struct {
+	// Identifier is the list identifier of the catalog.
+	Identifier []byte
+
+	// SequenceNumber is the sequence number of the catalog, if present.
+	SequenceNumber *big.Int
+
+	// ThisUpdate is the time at which the catalog was issued.
+	ThisUpdate time.Time
+
+	// NextUpdate is the time by which the catalog will be reissued. It is
+	// zero if not present.
+	NextUpdate time.Time
+
+	// Attributes holds the name and value attributes of the catalog
+	// itself, such as its OS attributes.
+	Attributes []CatalogAttribute
+
+	// Members holds the members of the catalog.
+	Members []CatalogMember
+
+	// Certificates holds the certificates that were embedded in the
+	// catalog's signature.
+	Certificates []*x509.Certificate
+
+	// Signer describes the entity that signed the catalog.
+	Signer Signer
+}
+
+// CatalogMember is a member of a security catalog that records the digest
+// of a single file.
+type CatalogMember struct {
+	// Identifier is the subject identifier of the member. It is usually a
+	// UTF-16 encoded string. See [CatalogMember.Tag].
+	Identifier []byte
+
+	// DigestAlgorithm is the hash function that was used to compute the
+	// member's digest.
+	DigestAlgorithm crypto.Hash
+
+	// Digest is the digest of the member's file.
+	Digest []byte
+
+	// Image is true if the digest is the Authenticode digest of a
+	// portable executable image. If false, the digest is a hash of the
+	// entire file.
+	Image bool
+
+	// SubjectGUID identifies the subject interface package that computed
+	// the digest, if present.
+	SubjectGUID string
+
+	// Attributes holds the name and value attributes of the member, such
+	// as its file name.
+	Attributes []CatalogAttribute
+}
+
+// Tag returns the member's subject identifier as a string. Identifiers are
+// usually UTF-16 encoded strings holding the hexadecimal digest of the
+// member. Identifiers that don't look like UTF-16 are returned in
+// hexadecimal form.
+func (member CatalogMember) Tag() string {
+	if n := len(member.Identifier); n > 0 && n%2 == 0 {
+		tag := strings.TrimRight(bytesconv.DecodeUTF16(member.Identifier, binary.LittleEndian), "\x00")
+		if tag != "" && strings.IndexFunc(tag, func(r rune) bool { return !unicode.IsPrint(r) }) < 0 {
+			return tag
+		}
+	}
+	return strings.ToUpper(hex.EncodeToString(member.Identifier))
+}
+
+// CatalogAttribute is a named attribute of a catalog or catalog member.
+type CatalogAttribute struct {
+	Name  string
+	Flags uint32
+	Value string
+}
+
+// ParseCatalog parses the given DER-encoded PKCS#7 ContentInfo as a
+// security catalog.
+//
+// It verifies that the catalog was signed by the private key of the
+// signer's embedded certificate, and that the signed message digest
+// matches the certificate trust list. If the signature doesn't match, it
+// returns an error that wraps [ErrInvalidCatalogSignature]. It does not
+// check whether the signer's certificate is valid or trusted. Callers must
+// do so before relying on the catalog's members.
+func ParseCatalog(data []byte) (Catalog, error) {
+	sd, err := parseSignedData(data)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	if !sd.ContentInfo.ContentType.Equal(oidCTL) {
+		return Catalog{}, fmt.Errorf("the signed data has a content type of %s instead of a certificate trust list", sd.ContentInfo.ContentType)
+	}
+
+	// Catalogs produced by Windows hold the list directly, but CMS encoders
+	// may wrap it in an octet string. Either way, the signature covers the
+	// contents octets of the value.
+	var value asn1.RawValue
+	if _, err := asn1.Unmarshal(sd.ContentInfo.Content.Bytes, &value); err != nil {
+		return Catalog{}, fmt.Errorf("failed to parse certificate trust list: %w", err)
+	}
+	content := value.FullBytes
+	if value.Class == asn1.ClassUniversal && value.Tag == asn1.TagOctetString {
+		content = value.Bytes
+	}
+
+	var list certificateTrustList
+	if _, err := asn1.Unmarshal(content, &list); err != nil {
+		return Catalog{}, fmt.Errorf("failed to parse certificate trust list: %w", err)
+	}
+
+	isCatalog := false
+	for _, usage := range list.SubjectUsage {
+		if usage.Equal(oidCatalogList) {
+			isCatalog = true
+			break
+		}
+	}
+	if !isCatalog {
+		return Catalog{}, fmt.Errorf("the certificate trust list is not a catalog list")
+	}
+
+	if alg := list.SubjectAlgorithm.Algorithm; !alg.Equal(oidCatalogListMember) && !alg.Equal(oidCatalogListMember2) {
+		return Catalog{}, fmt.Errorf("the catalog has an unrecognized member type of %s", alg)
+	}
+
+	cat := Catalog{
+		Identifier:     list.ListIdentifier,
+		SequenceNumber: list.SequenceNumber,
+		ThisUpdate:     list.ThisUpdate,
+		NextUpdate:     list.NextUpdate,
+	}
+
+	for _, ext := range list.Extensions {
+		if !ext.Id.Equal(oidCatalogNameValue) {
+			continue
+		}
+		attr, err := parseCatalogAttribute(ext.Value)
+		if err != nil {
+			return Catalog{}, err
+		}
+		cat.Attributes = append(cat.Attributes, attr)
+	}
+
+	for i, subject := range list.TrustedSubjects {
+		member, err := parseCatalogMember(subject)
+		if err != nil {
+			return Catalog{}, fmt.Errorf("failed to parse catalog member %d: %w", i, err)
+		}
+		cat.Members = append(cat.Members, member)
+	}
+
+	if cat.Certificates, err = sd.certificates(); err != nil {
+		return Catalog{}, err
+	}
+
+	infos, err := sd.signerInfos()
+	if err != nil {
+		return Catalog{}, err
+	}
+	if len(infos) != 1 {
+		return Catalog{}, fmt.Errorf("the signed data has %d signer infos instead of exactly one", len(infos))
+	}
+
+	if cat.Signer, _, err = parseSigner(infos[0], cat.Certificates); err != nil {
+		return Catalog{}, err
+	}
+	if cat.Signer.Certificate == nil {
+		return Catalog{}, errors.New("the catalog does not hold the certificate of its signer")
+	}
+	if err := infos[0].verify(cat.Signer.Certificate, value.Bytes); err != nil {
+		return Catalog{}, fmt.Errorf("%w: %w", ErrInvalidCatalogSignature, err)
+	}
+
+	return cat, nil
+}
+
+// Find returns the first member of the catalog with the given digest.
+func (cat *Catalog) Find(digest []byte) (CatalogMember, bool) {
+	for _, member := range cat.Members {
+		if bytes.Equal(member.Digest, digest) {
+			return member, true
+		}
+	}
+	return CatalogMember{}, false
+}
+
+// CatalogMatch identifies a catalog member that matches an image file.
+type CatalogMatch struct {
+	Catalog *Catalog
+	Member  CatalogMember
+}
+
+// MatchCatalogs computes the Authenticode digest of the image file read by
+// pe and returns the members of the given catalogs that record it.
+//
+// A digest is computed for each of the digest algorithms used by image
+// members of the catalogs. If the image is not a member of any of the
+// catalogs, an empty slice is returned.
+//
+// The catalogs are trusted as given. [ParseCatalog] verifies each
+// catalog's signature against its signer's certificate, but a match only
+// vouches for the image if the caller has also established trust in that
+// certificate.
+func MatchCatalogs(pe *portableexecutable.Reader, catalogs ...*Catalog) ([]CatalogMatch, error) {
+	digests := make(map[crypto.Hash][]byte)
+	var matches []CatalogMatch
+	for _, cat := range catalogs {
+		for _, member := range cat.Members {
+			if !member.Image {
+				continue
+			}
+			digest, ok := digests[member.DigestAlgorithm]
+			if !ok {
+				var err error
+				if digest, err = Hash(pe, member.DigestAlgorithm); err != nil {
+					return nil, err
+				}
+				digests[member.DigestAlgorithm] = digest
+			}
+			if bytes.Equal(member.Digest, digest) {
+				matches = append(matches, CatalogMatch{Catalog: cat, Member: member})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// parseCatalogMember parses a trusted subject of a catalog list.
+func parseCatalogMember(subject trustedSubject) (CatalogMember, error) {
+	attrs, err := parseAttributes(subject.Attributes.Bytes)
+	if err != nil {
+		return CatalogMember{}, err
+	}
+
+	member := CatalogMember{Identifier: subject.Identifier}
+
+	for _, attr := range attrs {
+		if len(attr.Values) == 0 {
+			continue
+		}
+		value := attr.Values[0].FullBytes
+		switch {
+		case attr.Type.Equal(oidSpcIndirectData):
+			var content spcIndirectDataContent
+			if _, err := asn1.Unmarshal(value, &content); err != nil {
+				return CatalogMember{}, fmt.Errorf("failed to parse SPC indirect data content: %w", err)
+			}
+			if member.DigestAlgorithm, err = hashForAlgorithm(content.MessageDigest.DigestAlgorithm); err != nil {
+				return CatalogMember{}, fmt.Errorf("failed to interpret the member digest algorithm: %w", err)
+			}
+			member.Digest = content.MessageDigest.Digest
+			member.Image = content.Data.Type.Equal(oidSpcPEImageData)
+		case attr.Type.Equal(oidCatalogMemberInfo):
+			var info catalogMemberInfo
+			if _, err := asn1.Unmarshal(value, &info); err != nil {
+				return CatalogMember{}, fmt.Errorf("failed to parse catalog member info: %w", err)
+			}
+			member.SubjectGUID = info.SubjectGUID
+		case attr.Type.Equal(oidCatalogNameValue):
+			nv, err := parseCatalogAttribute(value)
+			if err != nil {
+				return CatalogMember{}, err
+			}
+			member.Attributes = append(member.Attributes, nv)
+		}
+	}
+
+	return member, nil
+}
+
+// parseCatalogAttribute parses a DER-encoded catalog name and value.
+func parseCatalogAttribute(data []byte) (CatalogAttribute, error) {
+	var nv catalogNameValue
+	if _, err := asn1.Unmarshal(data, &nv); err != nil {
+		return CatalogAttribute{}, fmt.Errorf("failed to parse catalog name and value: %w", err)
+	}
+	return CatalogAttribute{
+		Name:  nv.Name,
+		Flags: uint32(nv.Flags),
+		Value: strings.TrimRight(bytesconv.DecodeUTF16(nv.Value, binary.LittleEndian), "\x00"),
+	}, nil
+}
+
+// certificateTrustList is the content of a security catalog.
+type certificateTrustList struct {
+	Version          int `asn1:"optional"`
+	SubjectUsage     []asn1.ObjectIdentifier
+	ListIdentifier   []byte   `asn1:"optional"`
+	SequenceNumber   *big.Int `asn1:"optional"`
+	ThisUpdate       time.Time
+	NextUpdate       time.Time `asn1:"optional"`
+	SubjectAlgorithm pkix.AlgorithmIdentifier
+	TrustedSubjects  []trustedSubject `asn1:"optional"`
+	Extensions       []pkix.Extension `asn1:"optional,explicit,tag:0"`
+}
+
+// trustedSubject is a member of a certificate trust list.
+//
+// The attributes are captured as a raw value so that they can be parsed
+// leniently.
+type trustedSubject struct {
+	Identifier []byte
+	Attributes asn1.RawValue `asn1:"optional"`
+}
+
+// catalogMemberInfo holds the subject interface package that was used to
+// compute the digest of a catalog member.
+type catalogMemberInfo struct {
+	SubjectGUID string
+	CertVersion int
+}
+
+// catalogNameValue holds a named attribute of a catalog or catalog member.
+type catalogNameValue struct {
+	Name  string
+	Flags int64
+	Value []byte
+}
@@ -0,0 +1,50 @@
+package authenticode
+
+import (
+	"crypto"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	// Register the hash functions that may be used by Authenticode
+	// signatures, so that crypto.Hash.New succeeds for each of them.
+	_ "crypto/md5"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// digestAlgorithms maps digest algorithm object identifiers to hash
+// functions.
+var digestAlgorithms = []struct {
+	oid  asn1.ObjectIdentifier
+	hash crypto.Hash
+}{
+	{oidDigestMD5, crypto.MD5},
+	{oidDigestSHA1, crypto.SHA1},
+	{oidDigestSHA256, crypto.SHA256},
+	{oidDigestSHA384, crypto.SHA384},
+	{oidDigestSHA512, crypto.SHA512},
+}
+
+// hashForAlgorithm returns the hash function identified by the given
+// algorithm identifier.
+func hashForAlgorithm(algorithm pkix.AlgorithmIdentifier) (crypto.Hash, error) {
+	for _, entry := range digestAlgorithms {
+		if entry.oid.Equal(algorithm.Algorithm) {
+			return entry.hash, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported digest algorithm: %s", algorithm.Algorithm)
+}
+
+// algorithmForHash returns the algorithm identifier for the given hash
+// function.
+func algorithmForHash(hash crypto.Hash) (pkix.AlgorithmIdentifier, error) {
+	for _, entry := range digestAlgorithms {
+		if entry.hash == hash {
+			return pkix.AlgorithmIdentifier{Algorithm: entry.oid, Parameters: asn1.NullRawValue}, nil
+		}
+	}
+	return pkix.AlgorithmIdentifier{}, fmt.Errorf("unsupported digest algorithm: %s", hash)
+}
@@ -0,0 +1,78 @@
+package authenticode
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/certificatetable"
+)
+
+// Hash computes the Authenticode digest of the image file read by pe, using
+// the given hash function.
+//
+// The digest covers all of the image data except for the image checksum,
+// the certificate table data directory entry and the certificate table
+// itself. If the image does not have a certificate table and its length is
+// not a multiple of 8 bytes, the digest includes the zero padding that will
+// precede a certificate table when one is added.
+//
+// The size of the image must be available from pe. See
+// [portableexecutable.Reader.Size] for details.
+func Hash(pe *portableexecutable.Reader, algorithm crypto.Hash) ([]byte, error) {
+	if !algorithm.Available() {
+		return nil, fmt.Errorf("the hash algorithm %s is not available", algorithm)
+	}
+
+	size, err := pe.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	end := imagefile.FileOffset(size)
+	padding := uint(0)
+	if certificates := pe.DataDirectories().Get(imagefile.CertificateTableID); !certificates.IsZero() && certificates.Location.Start < end {
+		end = certificates.Location.Start
+	} else {
+		padding = certificatetable.Align(uint(end)) - uint(end)
+	}
+
+	excluded := []imagefile.FileRange{
+		pe.Layout().CheckSum(),
+		pe.Layout().DataDirectoryEntry(pe.Format(), imagefile.CertificateTableID),
+	}
+	slices.SortFunc(excluded, func(a, b imagefile.FileRange) int {
+		return int(a.Start) - int(b.Start)
+	})
+
+	h := algorithm.New()
+	offset := imagefile.FileOffset(0)
+	for _, exclusion := range excluded {
+		if exclusion.Length == 0 || exclusion.Start < offset || exclusion.Start > end {
+			continue
+		}
+		if err := copyRange(h, pe.Source(), offset, exclusion.Start); err != nil {
+			return nil, err
+		}
+		offset = exclusion.Start + imagefile.FileOffset(exclusion.Length)
+	}
+	if offset < end {
+		if err := copyRange(h, pe.Source(), offset, end); err != nil {
+			return nil, err
+		}
+	}
+	h.Write(make([]byte, padding))
+
+	return h.Sum(nil), nil
+}
+
+// copyRange copies the data from source between start and end to w.
+func copyRange(w io.Writer, source io.ReaderAt, start, end imagefile.FileOffset) error {
+	if _, err := io.Copy(w, io.NewSectionReader(source, int64(start), int64(end-start))); err != nil {
+		return fmt.Errorf("failed to read image data at location %s: %w", imagefile.FileRange{Start: start, Length: uint(end - start)}, err)
+	}
+	return nil
+}
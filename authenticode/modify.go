@@ -0,0 +1,175 @@
+package authenticode
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/tables/certificatetable"
+)
+
+// ErrUnsigned is returned when an operation requires an existing
+// Authenticode signature but the image file doesn't have one.
+var ErrUnsigned = errors.New("the portable executable does not have an authenticode signature")
+
+// Remove writes a copy of the image file read by pe to w, with all of its
+// signatures removed. The certificate table is dropped entirely, its data
+// directory entry is zeroed and the image checksum is rewritten.
+//
+// The size of the image must be available from pe. See
+// [portableexecutable.Reader.Size] for details.
+func Remove(w io.Writer, pe *portableexecutable.Reader) error {
+	return certificatetable.Remove(w, pe)
+}
+
+// AppendNested writes a copy of the image file read by pe to w, with the
+// given DER-encoded signature nested within its primary signature.
+//
+// The nested signature is added to the unauthenticated attributes of the
+// primary signature's signer, after any signatures that are already nested
+// there. This is how dual-signed images carry more than one signature.
+//
+// The nested signature must have been produced for the image file. Its
+// digest is checked against the image before it is added.
+//
+// If the image is not signed, it returns [ErrUnsigned].
+func AppendNested(w io.Writer, pe *portableexecutable.Reader, signature []byte) error {
+	sig, err := ParseSignature(signature)
+	if err != nil {
+		return fmt.Errorf("failed to parse the signature to be nested: %w", err)
+	}
+	digest, err := Hash(pe, sig.DigestAlgorithm)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(digest, sig.Digest) {
+		return errors.New("the signature to be nested was not produced for the image file")
+	}
+
+	certificates, err := certificatetable.ReadCertificates(pe)
+	if err == certificatetable.ErrMissingCertificateTable {
+		return ErrUnsigned
+	} else if err != nil {
+		return err
+	}
+
+	for i := range certificates {
+		if certificates[i].Type != certificatetable.TypePKCSSignedData {
+			continue
+		}
+		combined, err := Nest(certificates[i].Data, signature)
+		if err != nil {
+			return err
+		}
+		certificates[i].Data = combined
+		return certificatetable.WriteImage(w, pe, certificates...)
+	}
+
+	return ErrUnsigned
+}
+
+// Nest returns a copy of the DER-encoded primary signature with the given
+// DER-encoded signature nested within it. The nested signature is added
+// to the unauthenticated attributes of the primary signature's signer,
+// after any signatures that are already nested there.
+//
+// The authenticated portions of the primary signature are preserved
+// exactly, so its signature value remains valid.
+func Nest(primary, nested []byte) ([]byte, error) {
+	var outer contentInfo
+	if _, err := asn1.Unmarshal(primary, &outer); err != nil {
+		return nil, fmt.Errorf("failed to parse PKCS#7 content info: %w", err)
+	}
+	sd, err := parseSignedData(primary)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := sd.signerInfos()
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) != 1 {
+		return nil, fmt.Errorf("the signed data has %d signer infos instead of exactly one", len(infos))
+	}
+	info := infos[0]
+
+	// Remove any trailing data, such as alignment padding, from the nested
+	// signature.
+	var value asn1.RawValue
+	if _, err := asn1.Unmarshal(nested, &value); err != nil {
+		return nil, fmt.Errorf("failed to parse the nested signature: %w", err)
+	}
+
+	attrs, err := info.unauthenticated()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the signer's unauthenticated attributes: %w", err)
+	}
+	added := false
+	for i := range attrs {
+		if attrs[i].Type.Equal(oidSpcNestedSignature) {
+			attrs[i].Values = append(attrs[i].Values, asn1.RawValue{FullBytes: value.FullBytes})
+			added = true
+			break
+		}
+	}
+	if !added {
+		attrs = append(attrs, attribute{
+			Type:   oidSpcNestedSignature,
+			Values: []asn1.RawValue{{FullBytes: value.FullBytes}},
+		})
+	}
+
+	unauthenticated, err := marshalAttributesInOrder(attrs)
+	if err != nil {
+		return nil, err
+	}
+	info.UnauthenticatedAttributes = retag(unauthenticated, 1)
+
+	encodedInfo, err := asn1.Marshal(info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signer info: %w", err)
+	}
+	sd.SignerInfos = asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: encodedInfo}
+
+	encoded, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signed data: %w", err)
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: outer.ContentType,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: encoded},
+	})
+}
+
+// marshalAttributesInOrder returns the DER encoding of a SET OF attributes
+// with the attributes and their values kept in the given order. Unlike
+// [marshalAttributeSet] it doesn't sort them, because tools address nested
+// signatures by the order in which they were added. The unauthenticated
+// attributes aren't covered by the signature, so the order doesn't affect
+// its validity.
+func marshalAttributesInOrder(attrs []attribute) ([]byte, error) {
+	var content []byte
+	for _, attr := range attrs {
+		oid, err := asn1.Marshal(attr.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute %s: %w", attr.Type, err)
+		}
+		var values []byte
+		for _, value := range attr.Values {
+			values = append(values, value.FullBytes...)
+		}
+		set, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: values})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute %s: %w", attr.Type, err)
+		}
+		encoded, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: append(oid, set...)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute %s: %w", attr.Type, err)
+		}
+		content = append(content, encoded...)
+	}
+	return asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: content})
+}
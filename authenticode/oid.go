@@ -0,0 +1,37 @@
+package authenticode
+
+import "encoding/asn1"
+
+// Object identifiers used by PKCS#7 and Authenticode structures.
+var (
+	oidSignedData               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}        // id-signedData
+	oidContentType              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}        // id-contentType
+	oidMessageDigest            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}        // id-messageDigest
+	oidSigningTime              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}        // id-signingTime
+	oidCounterSignature         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 6}        // id-countersignature
+	oidTSTInfo                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4} // id-ct-TSTInfo
+	oidSpcIndirectData          = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 1, 4}    // SPC_INDIRECT_DATA_OBJID
+	oidSpcStatementType         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 1, 11}   // SPC_STATEMENT_TYPE_OBJID
+	oidSpcSpOpusInfo            = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 1, 12}   // SPC_SP_OPUS_INFO_OBJID
+	oidSpcIndividualCodeSigning = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 1, 21}   // SPC_INDIVIDUAL_SP_KEY_PURPOSE_OBJID
+	oidSpcPEImageData           = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 1, 15}   // SPC_PE_IMAGE_DATAOBJ
+	oidSpcPageHashesV1          = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 3, 1}    // SPC_PE_IMAGE_PAGE_HASHES_V1 (SHA-1)
+	oidSpcPageHashesV2          = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 3, 2}    // SPC_PE_IMAGE_PAGE_HASHES_V2 (SHA-256)
+	oidSpcNestedSignature       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 4, 1}    // szOID_NESTED_SIGNATURE
+	oidSpcRFC3161Timestamp      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 3, 3, 1}    // szOID_RFC3161_counterSign
+	oidCTL                      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 1}      // szOID_CTL
+	oidCatalogList              = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 12, 1, 1}   // szOID_CATALOG_LIST
+	oidCatalogListMember        = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 12, 1, 2}   // szOID_CATALOG_LIST_MEMBER
+	oidCatalogListMember2       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 12, 1, 3}   // szOID_CATALOG_LIST_MEMBER2
+	oidCatalogNameValue         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 12, 2, 1}   // CAT_NAMEVALUE_OBJID
+	oidCatalogMemberInfo        = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 12, 2, 2}   // CAT_MEMBERINFO_OBJID
+	oidEncryptionRSA            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSignatureECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidSignatureECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidSignatureECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+	oidDigestMD5                = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 5}
+	oidDigestSHA1               = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidDigestSHA256             = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidDigestSHA384             = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidDigestSHA512             = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
@@ -0,0 +1,260 @@
+package authenticode
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/asn1"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// PageSize is the size of the pages covered by each entry in a page hash
+// table.
+const PageSize = 4096
+
+// spcPageHashesClassID is the class ID of the serialized object that holds
+// page hashes within SpcPeImageData.
+var spcPageHashesClassID = []byte{0xa6, 0xb5, 0x86, 0xd5, 0xb4, 0xa1, 0x24, 0x66, 0xae, 0x05, 0xa2, 0x17, 0xda, 0x8e, 0x60, 0xd6}
+
+// PageHashTable is a table of page hashes that is embedded in some
+// Authenticode signatures. Windows uses it to validate individual pages of
+// an image as they are loaded into memory.
+//
+// Each entry holds the file offset of a page and the digest of its
+// content. The final entry holds the file offset of the end of the hashed
+// data and a zeroed digest.
+type PageHashTable struct {
+	// Algorithm is the hash function used to compute the page digests.
+	Algorithm crypto.Hash
+
+	// Entries holds the page hash entries, ordered by file offset.
+	Entries []PageHash
+}
+
+// IsZero returns true if the table has no entries.
+func (table PageHashTable) IsZero() bool {
+	return len(table.Entries) == 0
+}
+
+// PageHash is an entry within a page hash table.
+type PageHash struct {
+	Offset imagefile.FileOffset
+	Digest []byte
+}
+
+// PageMismatch describes a page whose content does not match the digest
+// recorded for it in a page hash table.
+type PageMismatch struct {
+	// Location is the range of the image file that was hashed.
+	Location imagefile.FileRange
+
+	// Expected is the digest recorded in the page hash table.
+	Expected []byte
+
+	// Actual is the digest computed from the image file.
+	Actual []byte
+}
+
+// Verify computes the digest of each page listed in the table from the
+// image data of pe and compares it against the recorded value. It returns
+// a list of the pages that do not match.
+//
+// Pages are hashed the way Windows hashes them. The first page holds the
+// image headers, which are hashed up to SizeOfHeaders while excluding the
+// image checksum and the certificate table data directory entry. Each
+// remaining page is located within a section and is hashed up to the end of
+// the section's raw data. Pages shorter than [PageSize] are padded with
+// zeroes.
+func (table PageHashTable) Verify(pe *portableexecutable.Reader) ([]PageMismatch, error) {
+	if !table.Algorithm.Available() {
+		return nil, fmt.Errorf("the page hash algorithm %s is not available", table.Algorithm)
+	}
+
+	// The final entry marks the end of the hashed data.
+	var mismatches []PageMismatch
+	for i := 0; i+1 < len(table.Entries); i++ {
+		entry := table.Entries[i]
+
+		var (
+			location imagefile.FileRange
+			actual   []byte
+			err      error
+		)
+		if entry.Offset == 0 {
+			location, actual, err = hashHeaderPage(pe, table.Algorithm)
+		} else {
+			location, actual, err = hashSectionPage(pe, table.Algorithm, entry.Offset)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(actual, entry.Digest) {
+			mismatches = append(mismatches, PageMismatch{
+				Location: location,
+				Expected: entry.Digest,
+				Actual:   actual,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+// hashHeaderPage computes the page digest of the image headers.
+func hashHeaderPage(pe *portableexecutable.Reader, algorithm crypto.Hash) (imagefile.FileRange, []byte, error) {
+	layout := pe.Layout()
+	location := imagefile.FileRange{Length: min(uint(pe.OptionalHeader().SizeOfHeaders()), PageSize)}
+	data, err := pe.ReadRange(location)
+	if err != nil {
+		return location, nil, fmt.Errorf("failed to read the image headers at location %s: %w", location, err)
+	}
+
+	excluded := []imagefile.FileRange{
+		layout.CheckSum(),
+		layout.DataDirectoryEntry(pe.Format(), imagefile.CertificateTableID),
+	}
+
+	h := algorithm.New()
+	offset := uint(0)
+	for _, exclusion := range excluded {
+		start := uint(exclusion.Start)
+		if exclusion.Length == 0 || start < offset || start+exclusion.Length > uint(len(data)) {
+			continue
+		}
+		h.Write(data[offset:start])
+		offset = start + exclusion.Length
+	}
+	h.Write(data[offset:])
+	h.Write(make([]byte, PageSize-len(data)))
+
+	return location, h.Sum(nil), nil
+}
+
+// hashSectionPage computes the page digest of the page at the given offset
+// within the raw data of a section.
+func hashSectionPage(pe *portableexecutable.Reader, algorithm crypto.Hash, offset imagefile.FileOffset) (imagefile.FileRange, []byte, error) {
+	for _, section := range pe.Sections() {
+		if !section.FileRange.Contains(offset) {
+			continue
+		}
+
+		end := section.FileRange.Start + imagefile.FileOffset(section.FileRange.Length)
+		location := imagefile.FileRange{
+			Start:  offset,
+			Length: min(uint(end-offset), PageSize),
+		}
+		data, err := pe.ReadRange(location)
+		if err != nil {
+			return location, nil, fmt.Errorf("failed to read page data at location %s: %w", location, err)
+		}
+
+		h := algorithm.New()
+		h.Write(data)
+		h.Write(make([]byte, PageSize-len(data)))
+		return location, h.Sum(nil), nil
+	}
+
+	return imagefile.FileRange{}, nil, fmt.Errorf("the page hash table entry for offset %s does not fall within any section of the image file", offset)
+}
+
+// spcPEImageData is the Authenticode structure that describes a signed
+// portable executable image.
+type spcPEImageData struct {
+	Flags asn1.BitString `asn1:"optional"`
+	File  asn1.RawValue  `asn1:"optional,explicit,tag:0"`
+}
+
+// spcSerializedObject is an opaque object embedded in an SpcLink.
+type spcSerializedObject struct {
+	ClassID        []byte
+	SerializedData []byte
+}
+
+// parsePageHashes extracts the page hash table from the given SpcPeImageData
+// value, if it has one.
+func parsePageHashes(value asn1.RawValue) (PageHashTable, error) {
+	if len(value.FullBytes) == 0 {
+		return PageHashTable{}, nil
+	}
+
+	var imageData spcPEImageData
+	if _, err := asn1.Unmarshal(value.FullBytes, &imageData); err != nil {
+		return PageHashTable{}, fmt.Errorf("failed to parse SPC PE image data: %w", err)
+	}
+
+	// Page hashes are stored within a serialized object, which is the
+	// moniker choice of SpcLink.
+	var link asn1.RawValue
+	if len(imageData.File.Bytes) == 0 {
+		return PageHashTable{}, nil
+	}
+	if _, err := asn1.Unmarshal(imageData.File.Bytes, &link); err != nil {
+		return PageHashTable{}, fmt.Errorf("failed to parse SPC link: %w", err)
+	}
+	if link.Class != asn1.ClassContextSpecific || link.Tag != 1 {
+		return PageHashTable{}, nil
+	}
+
+	var object spcSerializedObject
+	if _, err := asn1.UnmarshalWithParams(link.FullBytes, &object, "tag:1"); err != nil {
+		return PageHashTable{}, fmt.Errorf("failed to parse SPC serialized object: %w", err)
+	}
+	if !bytes.Equal(object.ClassID, spcPageHashesClassID) {
+		return PageHashTable{}, nil
+	}
+
+	var attrs []spcAttributeTypeAndOptionalValue
+	if _, err := asn1.UnmarshalWithParams(object.SerializedData, &attrs, "set"); err != nil {
+		return PageHashTable{}, fmt.Errorf("failed to parse page hash attributes: %w", err)
+	}
+
+	for _, attr := range attrs {
+		var algorithm crypto.Hash
+		switch {
+		case attr.Type.Equal(oidSpcPageHashesV1):
+			algorithm = crypto.SHA1
+		case attr.Type.Equal(oidSpcPageHashesV2):
+			algorithm = crypto.SHA256
+		default:
+			continue
+		}
+
+		var tables [][]byte
+		if _, err := asn1.UnmarshalWithParams(attr.Value.FullBytes, &tables, "set"); err != nil {
+			return PageHashTable{}, fmt.Errorf("failed to parse page hash table: %w", err)
+		}
+		if len(tables) == 0 {
+			continue
+		}
+
+		return decodePageHashes(algorithm, tables[0])
+	}
+
+	return PageHashTable{}, nil
+}
+
+// decodePageHashes decodes a packed array of page hash entries, each of
+// which is a 4 byte little-endian file offset followed by a digest.
+func decodePageHashes(algorithm crypto.Hash, data []byte) (PageHashTable, error) {
+	entrySize := 4 + algorithm.Size()
+	if len(data)%entrySize != 0 {
+		return PageHashTable{}, fmt.Errorf("the %s page hash table has a length of %d bytes, which is not a multiple of the %d byte entry size", algorithm, len(data), entrySize)
+	}
+
+	table := PageHashTable{
+		Algorithm: algorithm,
+		Entries:   make([]PageHash, 0, len(data)/entrySize),
+	}
+	for start := 0; start < len(data); start += entrySize {
+		entry := data[start : start+entrySize]
+		table.Entries = append(table.Entries, PageHash{
+			Offset: imagefile.FileOffset(binary.LittleEndian.Uint32(entry[0:4])),
+			Digest: entry[4:],
+		})
+	}
+	return table, nil
+}
@@ -0,0 +1,245 @@
+package authenticode
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// contentInfo is a PKCS#7 ContentInfo structure.
+//
+// Because the content is explicitly tagged, the bytes of the raw content
+// value hold the complete DER encoding of the inner content.
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+// signedData is a PKCS#7 SignedData structure.
+//
+// The certificates and signer infos are captured as raw values so that they
+// can be parsed leniently.
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      contentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+// signerInfo is a PKCS#7 SignerInfo structure.
+//
+// The authenticated attributes are captured as a raw value because their
+// DER encoding is what gets signed.
+type signerInfo struct {
+	Version                   int
+	SignerIdentifier          asn1.RawValue
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	AuthenticatedAttributes   asn1.RawValue `asn1:"optional,tag:0"`
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+	UnauthenticatedAttributes asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+// issuerAndSerialNumber identifies a certificate by its issuer and serial
+// number.
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// attribute is a PKCS#7 attribute with one or more values.
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// parseSignedData parses data as a DER-encoded ContentInfo that holds a
+// SignedData structure. Any trailing data, such as alignment padding, is
+// ignored.
+func parseSignedData(data []byte) (signedData, error) {
+	var info contentInfo
+	if _, err := asn1.Unmarshal(data, &info); err != nil {
+		return signedData{}, fmt.Errorf("failed to parse PKCS#7 content info: %w", err)
+	}
+	if !info.ContentType.Equal(oidSignedData) {
+		return signedData{}, fmt.Errorf("the PKCS#7 content type is %s instead of signed data", info.ContentType)
+	}
+
+	var sd signedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &sd); err != nil {
+		return signedData{}, fmt.Errorf("failed to parse PKCS#7 signed data: %w", err)
+	}
+	return sd, nil
+}
+
+// certificates parses the certificates embedded in the signed data.
+//
+// The set may also hold attribute certificates and other certificate
+// formats that are identified by context-specific tags. These are skipped.
+func (sd signedData) certificates() ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := sd.Certificates.Bytes
+	for len(rest) > 0 {
+		var value asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &value); err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#7 certificate set: %w", err)
+		}
+		if value.Class != asn1.ClassUniversal || value.Tag != asn1.TagSequence {
+			continue
+		}
+		cert, err := x509.ParseCertificate(value.FullBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#7 certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// signerInfos parses the signer infos embedded in the signed data.
+func (sd signedData) signerInfos() ([]signerInfo, error) {
+	var infos []signerInfo
+	rest := sd.SignerInfos.Bytes
+	for len(rest) > 0 {
+		var info signerInfo
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &info); err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#7 signer info: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// issuerAndSerial returns the issuer and serial number that identify the
+// signer's certificate. It returns false if the signer is identified by
+// some other means, such as a subject key identifier.
+func (info signerInfo) issuerAndSerial() (issuerAndSerialNumber, bool) {
+	if info.SignerIdentifier.Class != asn1.ClassUniversal || info.SignerIdentifier.Tag != asn1.TagSequence {
+		return issuerAndSerialNumber{}, false
+	}
+	var id issuerAndSerialNumber
+	if _, err := asn1.Unmarshal(info.SignerIdentifier.FullBytes, &id); err != nil {
+		return issuerAndSerialNumber{}, false
+	}
+	return id, true
+}
+
+// authenticated parses the authenticated attributes of the signer info.
+func (info signerInfo) authenticated() ([]attribute, error) {
+	return parseAttributes(info.AuthenticatedAttributes.Bytes)
+}
+
+// unauthenticated parses the unauthenticated attributes of the signer info.
+func (info signerInfo) unauthenticated() ([]attribute, error) {
+	return parseAttributes(info.UnauthenticatedAttributes.Bytes)
+}
+
+// findCertificate returns the certificate from certs that matches the
+// signer's identifier, or nil if no match is found.
+func (info signerInfo) findCertificate(certs []*x509.Certificate) *x509.Certificate {
+	// Version 3 signer infos may identify the certificate by its subject
+	// key identifier instead of its issuer and serial number.
+	if id := info.SignerIdentifier; id.Class == asn1.ClassContextSpecific && id.Tag == 0 {
+		for _, cert := range certs {
+			if len(cert.SubjectKeyId) > 0 && bytes.Equal(cert.SubjectKeyId, id.Bytes) {
+				return cert
+			}
+		}
+		return nil
+	}
+
+	id, ok := info.issuerAndSerial()
+	if !ok {
+		return nil
+	}
+	for _, cert := range certs {
+		if cert.SerialNumber.Cmp(id.SerialNumber) == 0 && bytes.Equal(cert.RawIssuer, id.Issuer.FullBytes) {
+			return cert
+		}
+	}
+	return nil
+}
+
+// verify checks that the signer info holds a valid signature of content
+// made by the private key that corresponds to cert. The content must be
+// the contents octets of the signed data's content, without its tag and
+// length.
+//
+// If the signer info has authenticated attributes, their message digest
+// must match the digest of the content and the signature covers the
+// attributes. Otherwise the signature covers the content directly.
+func (info signerInfo) verify(cert *x509.Certificate, content []byte) error {
+	hash, err := hashForAlgorithm(info.DigestAlgorithm)
+	if err != nil {
+		return fmt.Errorf("failed to interpret the signer digest algorithm: %w", err)
+	}
+	h := hash.New()
+	h.Write(content)
+	digest := h.Sum(nil)
+
+	if len(info.AuthenticatedAttributes.FullBytes) > 0 {
+		attrs, err := info.authenticated()
+		if err != nil {
+			return fmt.Errorf("failed to parse the signer's authenticated attributes: %w", err)
+		}
+		values := findAttribute(attrs, oidMessageDigest)
+		if len(values) != 1 {
+			return errors.New("the signer's authenticated attributes do not hold exactly one message digest")
+		}
+		var messageDigest []byte
+		if _, err := asn1.Unmarshal(values[0].FullBytes, &messageDigest); err != nil {
+			return fmt.Errorf("failed to parse the signer's message digest: %w", err)
+		}
+		if !bytes.Equal(messageDigest, digest) {
+			return errors.New("the signer's message digest does not match the signed content")
+		}
+
+		// The signature covers the attributes encoded as a SET OF
+		// Attribute, rather than with their implicit tag.
+		encoded, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: info.AuthenticatedAttributes.Bytes})
+		if err != nil {
+			return err
+		}
+		h = hash.New()
+		h.Write(encoded)
+		digest = h.Sum(nil)
+	}
+
+	if err := verifySignatureValue(cert, hash, digest, info.EncryptedDigest); err != nil {
+		return fmt.Errorf("the signature value does not match the signer certificate: %w", err)
+	}
+	return nil
+}
+
+// parseAttributes parses a sequence of DER-encoded attributes.
+func parseAttributes(data []byte) ([]attribute, error) {
+	var attrs []attribute
+	for len(data) > 0 {
+		var attr attribute
+		var err error
+		if data, err = asn1.Unmarshal(data, &attr); err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#7 attribute: %w", err)
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs, nil
+}
+
+// findAttribute returns the values of the first attribute in attrs with
+// the given type.
+func findAttribute(attrs []attribute, oid asn1.ObjectIdentifier) []asn1.RawValue {
+	for _, attr := range attrs {
+		if attr.Type.Equal(oid) {
+			return attr.Values
+		}
+	}
+	return nil
+}
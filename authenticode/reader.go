@@ -0,0 +1,47 @@
+package authenticode
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/tables/certificatetable"
+)
+
+// ReadSignatures reads the Authenticode signatures embedded in the
+// certificate table of the given portable executable.
+//
+// Only the top-level signatures are returned. Nested signatures can be
+// accessed through each signature's Nested field, or by iterating over
+// [Signature.All].
+//
+// If the portable executable does not have a certificate table, it returns
+// [certificatetable.ErrMissingCertificateTable].
+func ReadSignatures(pe *portableexecutable.Reader) ([]Signature, error) {
+	reader, err := certificatetable.NewReader(pe)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := reader.ReadTable()
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []Signature
+	for _, entry := range table {
+		if entry.Type != certificatetable.TypePKCSSignedData {
+			continue
+		}
+		data, err := reader.ReadContent(entry)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := ParseSignature(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the signature in the certificate table entry at %s: %w", entry.Location, err)
+		}
+		signatures = append(signatures, sig)
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,414 @@
+package authenticode
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"unicode/utf16"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/tables/certificatetable"
+)
+
+// SignatureOptions control the construction of an Authenticode signature.
+type SignatureOptions struct {
+	// Hash is the hash function used to compute the image digest and the
+	// digest of the signed attributes. If zero, SHA-256 is used.
+	Hash crypto.Hash
+
+	// ProgramName is an optional description of the signed program, which
+	// Windows displays in elevation prompts.
+	ProgramName string
+
+	// MoreInfoURL is an optional URL with more information about the
+	// signed program.
+	MoreInfoURL string
+}
+
+// SignatureRequest is an Authenticode signature that has been prepared for
+// an image file but has not yet been signed.
+//
+// It supports two-phase signing, where the digest to be signed is handed to
+// an external signer, such as a hardware security module, and the resulting
+// signature value is later combined with the request by calling
+// [SignatureRequest.Complete].
+type SignatureRequest struct {
+	hash    crypto.Hash
+	certs   []*x509.Certificate
+	content []byte // DER-encoded SpcIndirectDataContent
+	attrs   []byte // DER-encoded SET OF authenticated attributes
+}
+
+// NewSignatureRequest prepares an Authenticode signature for the image file
+// read by pe. The first certificate in certs must belong to the signer.
+// Any additional certificates, such as intermediate certificates, are
+// embedded in the signature to help verifiers build a chain.
+func NewSignatureRequest(pe *portableexecutable.Reader, certs []*x509.Certificate, opts SignatureOptions) (*SignatureRequest, error) {
+	if opts.Hash == 0 {
+		opts.Hash = crypto.SHA256
+	}
+	digest, err := Hash(pe, opts.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return NewSignatureRequestForDigest(digest, certs, opts)
+}
+
+// NewSignatureRequestForDigest prepares an Authenticode signature for an
+// image file with the given Authenticode digest, which must have been
+// computed with the hash function specified in opts.
+func NewSignatureRequestForDigest(digest []byte, certs []*x509.Certificate, opts SignatureOptions) (*SignatureRequest, error) {
+	if opts.Hash == 0 {
+		opts.Hash = crypto.SHA256
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("a signer certificate is required")
+	}
+	if len(digest) != opts.Hash.Size() {
+		return nil, fmt.Errorf("the image digest has a length of %d bytes instead of the %d bytes produced by %s", len(digest), opts.Hash.Size(), opts.Hash)
+	}
+	algorithm, err := algorithmForHash(opts.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := asn1.Marshal(spcIndirectDataContent{
+		Data: spcAttributeTypeAndOptionalValue{
+			Type:  oidSpcPEImageData,
+			Value: asn1.RawValue{FullBytes: obsoletePEImageData},
+		},
+		MessageDigest: digestInfo{
+			DigestAlgorithm: algorithm,
+			Digest:          digest,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode SPC indirect data content: %w", err)
+	}
+
+	// The message digest covers the content of the SpcIndirectDataContent
+	// sequence, without its tag and length.
+	var value asn1.RawValue
+	if _, err := asn1.Unmarshal(content, &value); err != nil {
+		return nil, err
+	}
+	h := opts.Hash.New()
+	h.Write(value.Bytes)
+
+	opusInfo, err := marshalOpusInfo(opts.ProgramName, opts.MoreInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := marshalAttributeSet([]attribute{
+		newAttribute(oidContentType, oidSpcIndirectData),
+		newAttribute(oidMessageDigest, h.Sum(nil)),
+		newAttribute(oidSpcStatementType, []asn1.ObjectIdentifier{oidSpcIndividualCodeSigning}),
+		{Type: oidSpcSpOpusInfo, Values: []asn1.RawValue{{FullBytes: opusInfo}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureRequest{
+		hash:    opts.Hash,
+		certs:   certs,
+		content: content,
+		attrs:   attrs,
+	}, nil
+}
+
+// HashFunc returns the hash function that was used to compute the digest
+// returned by [SignatureRequest.Digest].
+func (req *SignatureRequest) HashFunc() crypto.Hash {
+	return req.hash
+}
+
+// Digest returns the digest that must be signed by the signer's private
+// key. It is the digest of the signature's authenticated attributes, which
+// in turn hold the digest of the image.
+func (req *SignatureRequest) Digest() []byte {
+	h := req.hash.New()
+	h.Write(req.attrs)
+	return h.Sum(nil)
+}
+
+// Sign signs the request's digest with signer and returns the signature
+// value. The signer's public key must match the signer certificate.
+func (req *SignatureRequest) Sign(signer crypto.Signer) ([]byte, error) {
+	value, err := signer.Sign(rand.Reader, req.Digest(), req.hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the authenticode digest: %w", err)
+	}
+	return value, nil
+}
+
+// Complete combines the request with a signature value produced by the
+// signer's private key and returns the DER-encoded PKCS#7 signature, which
+// is suitable for embedding in a certificate table with [Embed].
+//
+// If timestamp is not empty, it must hold a DER-encoded RFC 3161 timestamp
+// token whose message imprint is the digest of the signature value. It is
+// attached to the signature as an unauthenticated attribute.
+func (req *SignatureRequest) Complete(signature, timestamp []byte) ([]byte, error) {
+	signerCert := req.certs[0]
+	if err := verifySignatureValue(signerCert, req.hash, req.Digest(), signature); err != nil {
+		return nil, fmt.Errorf("the signature value does not match the signer certificate: %w", err)
+	}
+
+	digestAlgorithm, err := algorithmForHash(req.hash)
+	if err != nil {
+		return nil, err
+	}
+	encryptionAlgorithm, err := encryptionAlgorithmFor(signerCert, req.hash)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerAndSerial, err := asn1.Marshal(issuerAndSerialNumber{
+		Issuer:       asn1.RawValue{FullBytes: signerCert.RawIssuer},
+		SerialNumber: signerCert.SerialNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := signerInfo{
+		Version:                   1,
+		SignerIdentifier:          asn1.RawValue{FullBytes: issuerAndSerial},
+		DigestAlgorithm:           digestAlgorithm,
+		AuthenticatedAttributes:   retag(req.attrs, 0),
+		DigestEncryptionAlgorithm: encryptionAlgorithm,
+		EncryptedDigest:           signature,
+	}
+
+	if len(timestamp) > 0 {
+		if err := checkTimestampToken(timestamp, signature); err != nil {
+			return nil, err
+		}
+		unauthenticated, err := marshalAttributeSet([]attribute{
+			{Type: oidSpcRFC3161Timestamp, Values: []asn1.RawValue{{FullBytes: timestamp}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		info.UnauthenticatedAttributes = retag(unauthenticated, 1)
+	}
+
+	return marshalSignedData(digestAlgorithm, req.content, req.certs, info)
+}
+
+// Sign computes the Authenticode digest of the image file read by pe,
+// signs it with signer and returns the DER-encoded PKCS#7 signature. The
+// first certificate in certs must belong to the signer.
+//
+// The returned signature can be embedded in the image file with [Embed].
+func Sign(pe *portableexecutable.Reader, signer crypto.Signer, certs []*x509.Certificate, opts SignatureOptions) ([]byte, error) {
+	req, err := NewSignatureRequest(pe, certs, opts)
+	if err != nil {
+		return nil, err
+	}
+	value, err := req.Sign(signer)
+	if err != nil {
+		return nil, err
+	}
+	return req.Complete(value, nil)
+}
+
+// Embed writes a copy of the image file read by pe to w, with its
+// certificate table replaced by one that holds the given DER-encoded
+// signature. The certificate table data directory entry and the image
+// checksum are updated to match.
+//
+// Any existing signatures are replaced. See [certificatetable.WriteImage]
+// for details.
+func Embed(w io.Writer, pe *portableexecutable.Reader, signature []byte) error {
+	return certificatetable.WriteImage(w, pe, certificatetable.Certificate{
+		Revision: certificatetable.Revision2,
+		Type:     certificatetable.TypePKCSSignedData,
+		Data:     signature,
+	})
+}
+
+// obsoletePEImageData is the DER encoding of the SpcPeImageData structure
+// that signing tools include in Authenticode signatures. It has no flags,
+// and a file link that holds the string "<<<Obsolete>>>".
+var obsoletePEImageData = func() []byte {
+	var name []byte
+	for _, c := range utf16.Encode([]rune("<<<Obsolete>>>")) {
+		name = append(name, byte(c>>8), byte(c))
+	}
+	str, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: name})
+	file, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: str})
+	link, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: file})
+	flags, _ := asn1.Marshal(asn1.BitString{})
+	data, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: append(flags, link...)})
+	return data
+}()
+
+// marshalOpusInfo returns the DER encoding of an SpcSpOpusInfo structure
+// with the given program name and URL, either of which may be empty.
+func marshalOpusInfo(programName, moreInfoURL string) ([]byte, error) {
+	var content []byte
+	if programName != "" {
+		var name []byte
+		for _, c := range utf16.Encode([]rune(programName)) {
+			name = append(name, byte(c>>8), byte(c))
+		}
+		str, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: name})
+		if err != nil {
+			return nil, err
+		}
+		field, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: str})
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, field...)
+	}
+	if moreInfoURL != "" {
+		url, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: []byte(moreInfoURL)})
+		if err != nil {
+			return nil, err
+		}
+		field, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 1, IsCompound: true, Bytes: url})
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, field...)
+	}
+	return asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: content})
+}
+
+// newAttribute returns an attribute with a single value. It panics if the
+// value cannot be encoded.
+func newAttribute(oid asn1.ObjectIdentifier, value any) attribute {
+	data, err := asn1.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return attribute{Type: oid, Values: []asn1.RawValue{{FullBytes: data}}}
+}
+
+// marshalAttributeSet returns the DER encoding of the given attributes as a
+// SET OF Attribute. The elements are sorted as DER requires.
+func marshalAttributeSet(attrs []attribute) ([]byte, error) {
+	encoded := make([][]byte, 0, len(attrs))
+	for _, attr := range attrs {
+		data, err := asn1.Marshal(attr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute %s: %w", attr.Type, err)
+		}
+		encoded = append(encoded, data)
+	}
+	slices.SortFunc(encoded, bytes.Compare)
+	return asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: bytes.Join(encoded, nil)})
+}
+
+// retag returns a raw value with the content of the given DER-encoded
+// constructed value and an implicit context-specific tag.
+func retag(data []byte, tag int) asn1.RawValue {
+	var value asn1.RawValue
+	if _, err := asn1.Unmarshal(data, &value); err != nil {
+		panic(err)
+	}
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tag, IsCompound: true, Bytes: value.Bytes}
+}
+
+// marshalSignedData returns the DER encoding of a PKCS#7 ContentInfo that
+// holds a SignedData structure with SPC indirect data content.
+func marshalSignedData(digestAlgorithm pkix.AlgorithmIdentifier, content []byte, certs []*x509.Certificate, info signerInfo) ([]byte, error) {
+	var rawCerts []byte
+	for _, cert := range certs {
+		rawCerts = append(rawCerts, cert.Raw...)
+	}
+
+	encodedInfo, err := asn1.Marshal(info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signer info: %w", err)
+	}
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlgorithm},
+		ContentInfo: contentInfo{
+			ContentType: oidSpcIndirectData,
+			Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content},
+		},
+		Certificates: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: rawCerts},
+		SignerInfos:  asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: encodedInfo},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signed data: %w", err)
+	}
+
+	return asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd},
+	})
+}
+
+// verifySignatureValue checks that signature is a valid signature of
+// digest made by the private key that corresponds to the certificate.
+//
+// The check is performed directly with the public key instead of through
+// the certificate, so that digests computed with SHA-1 are accepted.
+func verifySignatureValue(cert *x509.Certificate, hash crypto.Hash, digest, signature []byte) error {
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, digest, signature) {
+			return errors.New("ecdsa: verification error")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported signer public key type %T", cert.PublicKey)
+	}
+}
+
+// encryptionAlgorithmFor returns the signer info digest encryption
+// algorithm that corresponds to the certificate's public key and the given
+// hash function.
+func encryptionAlgorithmFor(cert *x509.Certificate, hash crypto.Hash) (pkix.AlgorithmIdentifier, error) {
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return pkix.AlgorithmIdentifier{Algorithm: oidEncryptionRSA, Parameters: asn1.NullRawValue}, nil
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return pkix.AlgorithmIdentifier{Algorithm: oidSignatureECDSAWithSHA256}, nil
+		case crypto.SHA384:
+			return pkix.AlgorithmIdentifier{Algorithm: oidSignatureECDSAWithSHA384}, nil
+		case crypto.SHA512:
+			return pkix.AlgorithmIdentifier{Algorithm: oidSignatureECDSAWithSHA512}, nil
+		}
+	}
+	return pkix.AlgorithmIdentifier{}, fmt.Errorf("unsupported combination of signer public key type %T and hash function %s", cert.PublicKey, hash)
+}
+
+// checkTimestampToken verifies that the given RFC 3161 timestamp token can
+// be parsed and that it was issued for the given signature value.
+func checkTimestampToken(token, signature []byte) error {
+	_, info, err := parseTimestampToken(token)
+	if err != nil {
+		return err
+	}
+	hash, err := hashForAlgorithm(info.MessageImprint.DigestAlgorithm)
+	if err != nil {
+		return fmt.Errorf("failed to interpret the RFC 3161 message imprint algorithm: %w", err)
+	}
+	h := hash.New()
+	h.Write(signature)
+	if !bytes.Equal(h.Sum(nil), info.MessageImprint.Digest) {
+		return errors.New("the RFC 3161 timestamp token was not issued for the signature value")
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package authenticode
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"iter"
+)
+
+// Signature is an Authenticode signature. It is a PKCS#7 SignedData
+// structure whose content holds the digest of a portable executable image.
+//
+// Images that are signed more than once, such as dual-signed images that
+// carry both SHA-1 and SHA-256 signatures, hold additional signatures
+// within the unauthenticated attributes of the primary signature's signer.
+// These are available through the signature's Nested field.
+type Signature struct {
+	// DigestAlgorithm is the hash function that was used to compute the
+	// image digest.
+	DigestAlgorithm crypto.Hash
+
+	// Digest is the Authenticode digest of the image that was signed.
+	Digest []byte
+
+	// PageHashes holds the page hash table that was included in the
+	// signature. It is empty if the signature does not include page hashes.
+	PageHashes PageHashTable
+
+	// Certificates holds the certificates that were embedded in the
+	// signature.
+	Certificates []*x509.Certificate
+
+	// Signer describes the entity that signed the image digest.
+	Signer Signer
+
+	// Nested holds the signatures that are nested within this one.
+	Nested []Signature
+}
+
+// ParseSignature parses the given DER-encoded PKCS#7 ContentInfo as an
+// Authenticode signature. Any signatures nested within it are also parsed.
+//
+// The data is typically the content of a certificate table entry. Trailing
+// data, such as alignment padding, is ignored.
+func ParseSignature(data []byte) (Signature, error) {
+	sd, err := parseSignedData(data)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	if !sd.ContentInfo.ContentType.Equal(oidSpcIndirectData) {
+		return Signature{}, fmt.Errorf("the signed data has a content type of %s instead of SPC indirect data", sd.ContentInfo.ContentType)
+	}
+
+	var content spcIndirectDataContent
+	if _, err := asn1.Unmarshal(sd.ContentInfo.Content.Bytes, &content); err != nil {
+		return Signature{}, fmt.Errorf("failed to parse SPC indirect data content: %w", err)
+	}
+
+	digestAlgorithm, err := hashForAlgorithm(content.MessageDigest.DigestAlgorithm)
+	if err != nil {
+		return Signature{}, fmt.Errorf("failed to interpret the image digest algorithm: %w", err)
+	}
+
+	var pageHashes PageHashTable
+	if content.Data.Type.Equal(oidSpcPEImageData) {
+		if pageHashes, err = parsePageHashes(content.Data.Value); err != nil {
+			return Signature{}, err
+		}
+	}
+
+	certs, err := sd.certificates()
+	if err != nil {
+		return Signature{}, err
+	}
+
+	infos, err := sd.signerInfos()
+	if err != nil {
+		return Signature{}, err
+	}
+	if len(infos) != 1 {
+		return Signature{}, fmt.Errorf("the signed data has %d signer infos instead of exactly one", len(infos))
+	}
+
+	signer, nested, err := parseSigner(infos[0], certs)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	return Signature{
+		DigestAlgorithm: digestAlgorithm,
+		Digest:          content.MessageDigest.Digest,
+		PageHashes:      pageHashes,
+		Certificates:    certs,
+		Signer:          signer,
+		Nested:          nested,
+	}, nil
+}
+
+// All returns an iterator over sig and all of the signatures nested within
+// it. Nested signatures are visited recursively in depth-first order,
+// immediately after the signature that contains them.
+func (sig Signature) All() iter.Seq[Signature] {
+	return func(yield func(Signature) bool) {
+		sig.walk(yield)
+	}
+}
+
+func (sig Signature) walk(yield func(Signature) bool) bool {
+	if !yield(sig) {
+		return false
+	}
+	for _, nested := range sig.Nested {
+		if !nested.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// spcIndirectDataContent is the content of an Authenticode signature.
+type spcIndirectDataContent struct {
+	Data          spcAttributeTypeAndOptionalValue
+	MessageDigest digestInfo
+}
+
+// spcAttributeTypeAndOptionalValue describes the type of data that was
+// signed, along with optional information about it.
+type spcAttributeTypeAndOptionalValue struct {
+	Type  asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"optional"`
+}
+
+// digestInfo holds a digest and the algorithm used to compute it.
+type digestInfo struct {
+	DigestAlgorithm pkix.AlgorithmIdentifier
+	Digest          []byte
+}
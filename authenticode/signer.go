@@ -0,0 +1,210 @@
+package authenticode
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"time"
+)
+
+// Signer describes the entity that signed an Authenticode signature.
+type Signer struct {
+	// DigestAlgorithm is the hash function that was used to compute the
+	// digest of the signer's authenticated attributes.
+	DigestAlgorithm crypto.Hash
+
+	// Certificate is the certificate of the signer. It is nil if the
+	// certificate was not embedded in the signature.
+	Certificate *x509.Certificate
+
+	// Timestamps holds the countersignatures that attest to the time at
+	// which the signature was made.
+	Timestamps []Timestamp
+}
+
+// parseSigner interprets info as the signer of an Authenticode signature
+// with the given embedded certificates. It returns the signer and any
+// signatures nested within its unauthenticated attributes.
+func parseSigner(info signerInfo, certs []*x509.Certificate) (Signer, []Signature, error) {
+	digestAlgorithm, err := hashForAlgorithm(info.DigestAlgorithm)
+	if err != nil {
+		return Signer{}, nil, fmt.Errorf("failed to interpret the signer digest algorithm: %w", err)
+	}
+
+	signer := Signer{
+		DigestAlgorithm: digestAlgorithm,
+		Certificate:     info.findCertificate(certs),
+	}
+
+	attrs, err := info.unauthenticated()
+	if err != nil {
+		return Signer{}, nil, fmt.Errorf("failed to parse the signer's unauthenticated attributes: %w", err)
+	}
+
+	var nested []Signature
+	for _, attr := range attrs {
+		switch {
+		case attr.Type.Equal(oidCounterSignature):
+			for _, value := range attr.Values {
+				timestamp, err := parseCounterSignature(value, certs)
+				if err != nil {
+					return Signer{}, nil, err
+				}
+				signer.Timestamps = append(signer.Timestamps, timestamp)
+			}
+		case attr.Type.Equal(oidSpcRFC3161Timestamp):
+			for _, value := range attr.Values {
+				timestamp, err := parseRFC3161Timestamp(value)
+				if err != nil {
+					return Signer{}, nil, err
+				}
+				signer.Timestamps = append(signer.Timestamps, timestamp)
+			}
+		case attr.Type.Equal(oidSpcNestedSignature):
+			for _, value := range attr.Values {
+				sig, err := ParseSignature(value.FullBytes)
+				if err != nil {
+					return Signer{}, nil, fmt.Errorf("failed to parse nested signature: %w", err)
+				}
+				nested = append(nested, sig)
+			}
+		}
+	}
+
+	return signer, nested, nil
+}
+
+// TimestampFormat identifies the format of a timestamp countersignature.
+type TimestampFormat int
+
+// Known timestamp formats.
+const (
+	LegacyTimestamp  TimestampFormat = 1 // A PKCS#9 countersignature, as produced by legacy Authenticode timestamp servers
+	RFC3161Timestamp TimestampFormat = 2 // An RFC 3161 timestamp token
+)
+
+// String returns a string representation of the timestamp format.
+func (format TimestampFormat) String() string {
+	switch format {
+	case LegacyTimestamp:
+		return "Legacy"
+	case RFC3161Timestamp:
+		return "RFC 3161"
+	default:
+		return fmt.Sprintf("<unrecognized timestamp format: %d>", int(format))
+	}
+}
+
+// Timestamp is a countersignature that attests to the time at which an
+// Authenticode signature was made.
+type Timestamp struct {
+	// Format is the format of the countersignature.
+	Format TimestampFormat
+
+	// Time is the time asserted by the timestamp authority.
+	Time time.Time
+
+	// DigestAlgorithm is the hash function that was used by the timestamp
+	// authority.
+	DigestAlgorithm crypto.Hash
+
+	// Certificate is the certificate of the timestamp authority. It is nil
+	// if the certificate was not embedded in the signature.
+	Certificate *x509.Certificate
+}
+
+// parseCounterSignature parses a PKCS#9 countersignature, which is a signer
+// info whose certificate is embedded in the enclosing signature.
+func parseCounterSignature(value asn1.RawValue, certs []*x509.Certificate) (Timestamp, error) {
+	var info signerInfo
+	if _, err := asn1.Unmarshal(value.FullBytes, &info); err != nil {
+		return Timestamp{}, fmt.Errorf("failed to parse countersignature: %w", err)
+	}
+
+	digestAlgorithm, err := hashForAlgorithm(info.DigestAlgorithm)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("failed to interpret the countersignature digest algorithm: %w", err)
+	}
+
+	attrs, err := info.authenticated()
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("failed to parse the countersignature's authenticated attributes: %w", err)
+	}
+
+	timestamp := Timestamp{
+		Format:          LegacyTimestamp,
+		DigestAlgorithm: digestAlgorithm,
+		Certificate:     info.findCertificate(certs),
+	}
+
+	if values := findAttribute(attrs, oidSigningTime); len(values) > 0 {
+		if _, err := asn1.Unmarshal(values[0].FullBytes, &timestamp.Time); err != nil {
+			return Timestamp{}, fmt.Errorf("failed to parse countersignature signing time: %w", err)
+		}
+	}
+
+	return timestamp, nil
+}
+
+// tstInfo is the leading portion of an RFC 3161 TSTInfo structure.
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint digestInfo
+	SerialNumber   asn1.RawValue
+	GenTime        time.Time `asn1:"generalized"`
+}
+
+// parseRFC3161Timestamp parses an RFC 3161 timestamp token, which is a
+// PKCS#7 SignedData structure that holds a TSTInfo structure.
+func parseRFC3161Timestamp(value asn1.RawValue) (Timestamp, error) {
+	timestamp, _, err := parseTimestampToken(value.FullBytes)
+	return timestamp, err
+}
+
+// parseTimestampToken parses the given DER-encoded RFC 3161 timestamp token
+// and returns the timestamp along with the TSTInfo structure it contains.
+func parseTimestampToken(data []byte) (Timestamp, tstInfo, error) {
+	sd, err := parseSignedData(data)
+	if err != nil {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("failed to parse RFC 3161 timestamp token: %w", err)
+	}
+	if !sd.ContentInfo.ContentType.Equal(oidTSTInfo) {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("the RFC 3161 timestamp token has a content type of %s instead of TSTInfo", sd.ContentInfo.ContentType)
+	}
+
+	// The TSTInfo structure is DER-encoded within an octet string.
+	var encoded []byte
+	if _, err := asn1.Unmarshal(sd.ContentInfo.Content.Bytes, &encoded); err != nil {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("failed to parse RFC 3161 timestamp token content: %w", err)
+	}
+	var info tstInfo
+	if _, err := asn1.Unmarshal(encoded, &info); err != nil {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("failed to parse RFC 3161 TSTInfo: %w", err)
+	}
+
+	certs, err := sd.certificates()
+	if err != nil {
+		return Timestamp{}, tstInfo{}, err
+	}
+	infos, err := sd.signerInfos()
+	if err != nil {
+		return Timestamp{}, tstInfo{}, err
+	}
+	if len(infos) != 1 {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("the RFC 3161 timestamp token has %d signer infos instead of exactly one", len(infos))
+	}
+
+	digestAlgorithm, err := hashForAlgorithm(infos[0].DigestAlgorithm)
+	if err != nil {
+		return Timestamp{}, tstInfo{}, fmt.Errorf("failed to interpret the RFC 3161 timestamp digest algorithm: %w", err)
+	}
+
+	return Timestamp{
+		Format:          RFC3161Timestamp,
+		Time:            info.GenTime,
+		DigestAlgorithm: digestAlgorithm,
+		Certificate:     infos[0].findCertificate(certs),
+	}, info, nil
+}
@@ -0,0 +1,60 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// FileType identifies the kind of file held by a bundle.
+type FileType uint8
+
+// Bundled file types.
+const (
+	FileUnknown           FileType = 0 // Unknown, A file that is extracted to disk when the bundle runs
+	FileAssembly          FileType = 1 // Assembly, A managed assembly
+	FileNativeBinary      FileType = 2 // NativeBinary, A native library
+	FileDepsJSON          FileType = 3 // DepsJson, The application's .deps.json file
+	FileRuntimeConfigJSON FileType = 4 // RuntimeConfigJson, The application's .runtimeconfig.json file
+	FileSymbols           FileType = 5 // Symbols, A symbol file
+)
+
+// String returns a string representation of the file type.
+func (t FileType) String() string {
+	switch t {
+	case FileUnknown:
+		return "Unknown"
+	case FileAssembly:
+		return "Assembly"
+	case FileNativeBinary:
+		return "NativeBinary"
+	case FileDepsJSON:
+		return "DepsJson"
+	case FileRuntimeConfigJSON:
+		return "RuntimeConfigJson"
+	case FileSymbols:
+		return "Symbols"
+	default:
+		return fmt.Sprintf("<unrecognized file type: %d>", uint8(t))
+	}
+}
+
+// File describes a file held by a bundle.
+type File struct {
+	// Path is the path of the file relative to the application, which
+	// uses forward slashes to separate directories.
+	Path string
+
+	Type FileType
+
+	// Location is the file range of the file's data within the bundle. If
+	// the file is compressed, it holds the compressed data.
+	Location imagefile.FileRange
+
+	// Size is the uncompressed size of the file.
+	Size uint64
+
+	// Compressed is true if the file's data is compressed with the
+	// deflate algorithm. Compression is supported by version 6 and later.
+	Compressed bool
+}
@@ -0,0 +1,68 @@
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Header is the header of a single-file bundle, which is followed by its
+// manifest.
+type Header struct {
+	// MajorVersion and MinorVersion identify the version of the bundle
+	// format. Version 1 was introduced by .NET Core 3, version 2 by .NET 5
+	// and version 6 by .NET 6.
+	MajorVersion uint32
+	MinorVersion uint32
+
+	// FileCount is the number of files held by the bundle.
+	FileCount uint32
+
+	// ID is a unique identifier for the bundle, which the host uses to
+	// name the directory that files are extracted to.
+	ID string
+
+	// DepsJSON and RuntimeConfigJSON are the locations of the
+	// application's .deps.json and .runtimeconfig.json files within the
+	// bundle. They are only recorded by version 2 and later.
+	DepsJSON          imagefile.FileRange
+	RuntimeConfigJSON imagefile.FileRange
+
+	// Flags are only recorded by version 2 and later.
+	Flags HeaderFlags
+}
+
+// HeaderFlags holds the flags of a bundle header.
+type HeaderFlags uint64
+
+// Bundle header flags.
+const (
+	FlagNetCoreApp3CompatMode HeaderFlags = 0x1 // NetcoreApp3CompatMode, All files are extracted to disk as they were by .NET Core 3
+)
+
+var headerFlagNames = []struct {
+	flag HeaderFlags
+	name string
+}{
+	{FlagNetCoreApp3CompatMode, "NetcoreApp3CompatMode"},
+}
+
+// String returns a string representation of the flags.
+func (flags HeaderFlags) String() string {
+	var names []string
+	remaining := flags
+	for _, entry := range headerFlagNames {
+		if flags&entry.flag != 0 {
+			names = append(names, entry.name)
+			remaining &^= entry.flag
+		}
+	}
+	if remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint64(remaining)))
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, " | ")
+}
@@ -0,0 +1,360 @@
+package bundle
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Signature is the signature that the .NET application host uses to
+// locate the placeholder for the bundle header offset. The placeholder is
+// the 8 bytes that precede the signature.
+var Signature = []byte{
+	0x8b, 0x12, 0x02, 0xb9, 0x6a, 0x61, 0x20, 0x38,
+	0x72, 0x7b, 0x93, 0x02, 0x14, 0xd7, 0xa0, 0x32,
+	0x13, 0xf5, 0xb9, 0xe6, 0xef, 0xae, 0x33, 0x18,
+	0xee, 0x3b, 0x2d, 0xce, 0x24, 0xb3, 0x6a, 0xae,
+}
+
+// placeholderSize is the size of the bundle header offset that precedes
+// the signature.
+const placeholderSize = 8
+
+// Minimum sizes of a manifest entry, which holds a 64-bit offset and size,
+// a type byte and a path with a length prefix of at least one byte.
+// Version 6 added a 64-bit compressed size.
+const (
+	minEntrySize   = 8 + 8 + 1 + 1
+	minEntrySizeV6 = minEntrySize + 8
+)
+
+var (
+	// ErrMissingBundle is returned by [NewReader] and [Locate] if the
+	// image file is not a single-file bundle. This includes application
+	// hosts that have a placeholder for a bundle that hasn't been written.
+	ErrMissingBundle = errors.New("the portable executable is not a single-file bundle")
+
+	// ErrUnsupportedVersion is returned by [NewReader] if the bundle has
+	// a major version that the reader doesn't understand.
+	ErrUnsupportedVersion = errors.New("the bundle has an unsupported version")
+)
+
+// Locate returns the file offset of the bundle header within the .NET
+// application host read by pe. It finds the placeholder that the host uses
+// to record the offset within its sections. It returns [ErrMissingBundle]
+// if the image file doesn't have a placeholder or the placeholder doesn't
+// hold an offset.
+func Locate(pe *portableexecutable.Reader) (imagefile.FileOffset, error) {
+	size, err := pe.Size()
+	if err != nil {
+		return 0, err
+	}
+	for _, section := range pe.Sections() {
+		if section.FileRange.Length == 0 {
+			continue
+		}
+		data, err := pe.ReadRange(section.FileRange)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read the \"%s\" section: %w", section.Name, err)
+		}
+		index := bytes.Index(data, Signature)
+		if index < placeholderSize {
+			continue
+		}
+		offset := int64(binary.LittleEndian.Uint64(data[index-placeholderSize : index]))
+		if offset == 0 {
+			return 0, ErrMissingBundle
+		}
+		if offset < 0 || offset >= size {
+			return 0, fmt.Errorf("the bundle header offset 0x%x exceeds the bounds of the image file", offset)
+		}
+		return imagefile.FileOffset(offset), nil
+	}
+	return 0, ErrMissingBundle
+}
+
+// Reader reads the files held by a .NET single-file bundle from an
+// underlying [portableexecutable.Reader].
+//
+// A single-file bundle is a .NET application host with the application's
+// files appended to it. The files are followed by a header and manifest
+// that describe them, and the host records the location of the header in
+// a placeholder within its own data.
+type Reader struct {
+	pe     *portableexecutable.Reader
+	offset imagefile.FileOffset
+	header Header
+	files  []File
+}
+
+// NewReader creates and initializes a new bundle [Reader] that reads from
+// the .NET application host read by pe. It returns [ErrMissingBundle] if
+// the image file is not a single-file bundle.
+//
+// It reads and validates the bundle header and its manifest. If the
+// validation fails it returns an error.
+func NewReader(pe *portableexecutable.Reader) (*Reader, error) {
+	offset, err := Locate(pe)
+	if err != nil {
+		return nil, err
+	}
+	size, err := pe.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	// The header and manifest are written after the files, at the end of
+	// the bundle.
+	data, err := pe.ReadRange(imagefile.FileRange{Start: offset, Length: uint(size - int64(offset))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the bundle manifest: %w", err)
+	}
+
+	r := &Reader{pe: pe, offset: offset}
+	m := manifestReader{data: data, size: size}
+	if err := m.header(&r.header); err != nil {
+		return nil, fmt.Errorf("failed to read the bundle header: %w", err)
+	}
+	entrySize := minEntrySize
+	if r.header.MajorVersion >= 6 {
+		entrySize = minEntrySizeV6
+	}
+	r.files = make([]File, 0, min(int(r.header.FileCount), (len(data)-m.pos)/entrySize))
+	for i := range r.header.FileCount {
+		file, err := m.file(r.header.MajorVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry %d of the bundle manifest: %w", i, err)
+		}
+		r.files = append(r.files, file)
+	}
+
+	return r, nil
+}
+
+// Offset returns the file offset of the bundle header.
+func (r *Reader) Offset() imagefile.FileOffset {
+	return r.offset
+}
+
+// Header returns the bundle header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Files returns the files held by the bundle, in the order they are
+// listed by the manifest.
+func (r *Reader) Files() []File {
+	return r.files
+}
+
+// File returns the file with the given path. It returns false if the
+// bundle doesn't hold a file with that path.
+func (r *Reader) File(path string) (File, bool) {
+	for _, file := range r.files {
+		if file.Path == path {
+			return file, true
+		}
+	}
+	return File{}, false
+}
+
+// Open returns a reader for the contents of the given file, which
+// decompresses the file's data if it is compressed.
+func (r *Reader) Open(file File) io.Reader {
+	section := io.NewSectionReader(r.pe.Source(), int64(file.Location.Start), int64(file.Location.Length))
+	if !file.Compressed {
+		return section
+	}
+	return io.LimitReader(flate.NewReader(section), int64(file.Size))
+}
+
+// ReadFile reads the contents of the given file, decompressing its data if
+// it is compressed.
+func (r *Reader) ReadFile(file File) ([]byte, error) {
+	if !file.Compressed {
+		data, err := r.pe.ReadRange(file.Location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundled file \"%s\" at location %s: %w", file.Path, file.Location, err)
+		}
+		return data, nil
+	}
+
+	// The uncompressed size comes from the manifest, so the data is read
+	// incrementally instead of allocating that size up front.
+	data, err := io.ReadAll(r.Open(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress bundled file \"%s\": %w", file.Path, err)
+	}
+	if uint64(len(data)) != file.Size {
+		return nil, fmt.Errorf("the bundled file \"%s\" decompressed to %d bytes when %d bytes were expected", file.Path, len(data), file.Size)
+	}
+	return data, nil
+}
+
+// manifestReader reads the components of a bundle header and manifest.
+type manifestReader struct {
+	data []byte
+	pos  int
+	size int64 // The size of the image file, which bounds file locations
+}
+
+func (m *manifestReader) take(n int) ([]byte, error) {
+	if n < 0 || n > len(m.data)-m.pos {
+		return nil, fmt.Errorf("the bundle manifest is truncated")
+	}
+	b := m.data[m.pos : m.pos+n]
+	m.pos += n
+	return b, nil
+}
+
+func (m *manifestReader) uint32() (uint32, error) {
+	b, err := m.take(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (m *manifestReader) uint64() (uint64, error) {
+	b, err := m.take(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// uint7 reads an integer that is encoded 7 bits at a time.
+func (m *manifestReader) uint7() (uint32, error) {
+	var value uint32
+	for shift := 0; shift < 35; shift += 7 {
+		b, err := m.take(1)
+		if err != nil {
+			return 0, err
+		}
+		value |= uint32(b[0]&0x7F) << shift
+		if b[0]&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("the bundle manifest has an invalid 7-bit encoded integer")
+}
+
+// string reads a UTF-8 string that is prefixed by its length.
+func (m *manifestReader) string() (string, error) {
+	length, err := m.uint7()
+	if err != nil {
+		return "", err
+	}
+	b, err := m.take(int(min(length, math.MaxInt32)))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// location reads a file range that is recorded as a 64-bit offset and
+// size.
+func (m *manifestReader) location() (imagefile.FileRange, error) {
+	offset, err := m.uint64()
+	if err != nil {
+		return imagefile.FileRange{}, err
+	}
+	size, err := m.uint64()
+	if err != nil {
+		return imagefile.FileRange{}, err
+	}
+	return m.bounded(offset, size)
+}
+
+// bounded returns the file range with the given offset and size, after
+// checking that it lies within the image file.
+func (m *manifestReader) bounded(offset, size uint64) (imagefile.FileRange, error) {
+	if offset > uint64(m.size) || size > uint64(m.size)-offset {
+		return imagefile.FileRange{}, fmt.Errorf("the bundle manifest refers to %d bytes at offset 0x%x, which exceed the bounds of the image file", size, offset)
+	}
+	return imagefile.FileRange{Start: imagefile.FileOffset(offset), Length: uint(size)}, nil
+}
+
+func (m *manifestReader) header(header *Header) error {
+	var err error
+	if header.MajorVersion, err = m.uint32(); err != nil {
+		return err
+	}
+	if header.MinorVersion, err = m.uint32(); err != nil {
+		return err
+	}
+	if header.MajorVersion < 1 || header.MajorVersion > 6 {
+		return fmt.Errorf("%w: %d.%d", ErrUnsupportedVersion, header.MajorVersion, header.MinorVersion)
+	}
+	if header.FileCount, err = m.uint32(); err != nil {
+		return err
+	}
+	if header.FileCount > math.MaxInt32 {
+		return fmt.Errorf("the bundle has an invalid file count of %d", int32(header.FileCount))
+	}
+	if header.ID, err = m.string(); err != nil {
+		return err
+	}
+	if header.MajorVersion < 2 {
+		return nil
+	}
+	if header.DepsJSON, err = m.location(); err != nil {
+		return err
+	}
+	if header.RuntimeConfigJSON, err = m.location(); err != nil {
+		return err
+	}
+	flags, err := m.uint64()
+	if err != nil {
+		return err
+	}
+	header.Flags = HeaderFlags(flags)
+	return nil
+}
+
+func (m *manifestReader) file(version uint32) (File, error) {
+	offset, err := m.uint64()
+	if err != nil {
+		return File{}, err
+	}
+	size, err := m.uint64()
+	if err != nil {
+		return File{}, err
+	}
+	var compressed uint64
+	if version >= 6 {
+		if compressed, err = m.uint64(); err != nil {
+			return File{}, err
+		}
+	}
+	t, err := m.take(1)
+	if err != nil {
+		return File{}, err
+	}
+	path, err := m.string()
+	if err != nil {
+		return File{}, err
+	}
+
+	file := File{
+		Path:       path,
+		Type:       FileType(t[0]),
+		Size:       size,
+		Compressed: compressed != 0,
+	}
+	stored := size
+	if file.Compressed {
+		stored = compressed
+	}
+	if file.Location, err = m.bounded(offset, stored); err != nil {
+		return File{}, fmt.Errorf("the bundled file \"%s\" is invalid: %w", path, err)
+	}
+	return file, nil
+}
@@ -0,0 +1,69 @@
+package portableexecutable
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// ErrCheckSumMismatch is returned by [Reader.VerifyCheckSum] when the
+// checksum stored in the optional header doesn't match the checksum
+// computed from the image file.
+var ErrCheckSumMismatch = errors.New("the image checksum does not match the image file")
+
+// ComputeCheckSum computes the checksum of the image file by reading all of
+// its data from the underlying source. It implements the algorithm used by
+// the CheckSumMappedFile function of the Windows image help library.
+//
+// The size of the image must be available. See [Reader.Size] for details.
+func (r *Reader) ComputeCheckSum() (uint32, error) {
+	size, err := r.Size()
+	if err != nil {
+		return 0, err
+	}
+	checksum := imagefile.NewCheckSumWriter(r.layout.CheckSum())
+	if _, err := io.Copy(checksum, io.NewSectionReader(r.source, 0, size)); err != nil {
+		return 0, fmt.Errorf("failed to read the image file while computing its checksum: %w", err)
+	}
+	return checksum.Sum(), nil
+}
+
+// VerifyCheckSum computes the checksum of the image file and compares it
+// with the checksum stored in its optional header. If they differ it
+// returns an error that wraps [ErrCheckSumMismatch].
+//
+// Many images that aren't drivers or boot-critical components have a
+// stored checksum of zero, which will not match.
+func (r *Reader) VerifyCheckSum() error {
+	computed, err := r.ComputeCheckSum()
+	if err != nil {
+		return err
+	}
+	if stored := r.optionalHeader.CheckSum(); stored != computed {
+		return fmt.Errorf("%w: the stored checksum is 0x%08x but the computed checksum is 0x%08x", ErrCheckSumMismatch, stored, computed)
+	}
+	return nil
+}
+
+// WriteCheckSum computes the checksum of the image file and writes it to
+// the checksum field of the optional header through w, which is expected
+// to write to the same image file that r reads from. It returns the
+// checksum that was written.
+//
+// The optional header that was read by r is not updated.
+func (r *Reader) WriteCheckSum(w io.WriterAt) (uint32, error) {
+	computed, err := r.ComputeCheckSum()
+	if err != nil {
+		return 0, err
+	}
+	field := r.layout.CheckSum()
+	var data [imagefile.CheckSumSize]byte
+	binary.LittleEndian.PutUint32(data[:], computed)
+	if _, err := w.WriteAt(data[:], int64(field.Start)); err != nil {
+		return 0, fmt.Errorf("failed to write the image checksum at offset %s: %w", field.Start, err)
+	}
+	return computed, nil
+}
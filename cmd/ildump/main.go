@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/cil"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+)
+
+// methodCodeTypeMask selects the code type of a method from its
+// implementation flags. Methods with a code type of zero are implemented
+// in CIL.
+const methodCodeTypeMask = 0x0003
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Printf("Please provide the path to a managed portable executable file (.exe or .dll).\n")
+		os.Exit(1)
+	}
+
+	path := os.Args[1]
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Printf("Failed to open \"%s\": %v\n", path, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	reader, err := portableexecutable.NewReader(file)
+	if err != nil {
+		fmt.Printf("Failed to read information for \"%s\": %v\n", path, err)
+		os.Exit(1)
+	}
+	clr, err := clrheader.NewReader(reader)
+	if err != nil {
+		fmt.Printf("Failed to read the CLR header: %v\n", err)
+		os.Exit(1)
+	}
+	md, err := metadata.NewReader(clr)
+	if err != nil {
+		fmt.Printf("Failed to read the metadata: %v\n", err)
+		os.Exit(1)
+	}
+	methods, err := md.MethodDefTable()
+	if err != nil {
+		fmt.Printf("Failed to read the methods: %v\n", err)
+		os.Exit(1)
+	}
+
+	for i, method := range methods {
+		if method.RVA == 0 || method.ImplFlags&methodCodeTypeMask != 0 {
+			continue
+		}
+		token := metadata.NewToken(metadata.TableMethodDef, uint32(i+1))
+		fmt.Printf(".method %s\n", methodName(md, token, method))
+
+		body, err := cil.ReadMethodBody(reader, method.RVA)
+		if err != nil {
+			fmt.Printf("  Failed to read the method body: %v\n", err)
+			continue
+		}
+		fmt.Printf("  .maxstack %d\n", body.MaxStack)
+		if !body.LocalVarSig.IsNil() {
+			fmt.Printf("  .locals %s\n", localsSignature(md, body.LocalVarSig))
+		}
+		for _, clause := range body.ExceptionClauses {
+			fmt.Printf("  %s\n", formatClause(md, clause))
+		}
+		instructions, err := cil.Decode(body.Code)
+		for _, inst := range instructions {
+			fmt.Printf("  %s\n", inst.Format(md))
+		}
+		if err != nil {
+			fmt.Printf("  Failed to decode the instructions: %v\n", err)
+		}
+	}
+}
+
+// methodName returns the name of a method and the types of its return
+// value and parameters.
+func methodName(md *metadata.Reader, token metadata.Token, method metadata.MethodDef) string {
+	name, err := md.DisplayName(token)
+	if err != nil {
+		name = token.String()
+	}
+	signature, err := metadata.ParseMethodSignature(method.Signature)
+	if err != nil {
+		return name
+	}
+	params := make([]string, len(signature.Params))
+	for i := range signature.Params {
+		params[i] = md.FormatType(signature.Params[i])
+	}
+	return fmt.Sprintf("%s %s(%s)", md.FormatType(signature.Return), name, strings.Join(params, ", "))
+}
+
+// localsSignature returns the types of the local variables described by
+// the given StandAloneSig token.
+func localsSignature(md *metadata.Reader, token metadata.Token) string {
+	sig, err := md.StandAloneSig(token.Row())
+	if err != nil {
+		return token.String()
+	}
+	locals, err := metadata.ParseLocalSignature(sig.Signature)
+	if err != nil {
+		return token.String()
+	}
+	types := make([]string, len(locals))
+	for i := range locals {
+		types[i] = md.FormatType(locals[i])
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+// formatClause returns a string representation of an exception handling
+// clause.
+func formatClause(md *metadata.Reader, clause cil.ExceptionClause) string {
+	text := fmt.Sprintf(".try IL_%04x to IL_%04x %s", clause.TryOffset, clause.TryOffset+clause.TryLength, clause.Kind)
+	switch clause.Kind {
+	case cil.ClauseCatch:
+		if name, err := md.DisplayName(clause.CatchType); err == nil {
+			text += " " + name
+		} else {
+			text += " " + clause.CatchType.String()
+		}
+	case cil.ClauseFilter:
+		text += fmt.Sprintf(" IL_%04x", clause.FilterOffset)
+	}
+	return text + fmt.Sprintf(" handler IL_%04x to IL_%04x", clause.HandlerOffset, clause.HandlerOffset+clause.HandlerLength)
+}
@@ -7,10 +7,24 @@ import (
 	"time"
 
 	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/authenticode"
+	"github.com/gentlemanautomaton/portableexecutable/bundle"
+	"github.com/gentlemanautomaton/portableexecutable/dos"
 	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/le"
+	"github.com/gentlemanautomaton/portableexecutable/ne"
+	"github.com/gentlemanautomaton/portableexecutable/sbat"
+	"github.com/gentlemanautomaton/portableexecutable/tables/certificatetable"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/readytorun"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/resources"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/strongname"
 	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory"
 	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory/resourcetype"
 	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory/resourcetype/versioninfo"
+	"github.com/gentlemanautomaton/portableexecutable/te"
+	"github.com/gentlemanautomaton/portableexecutable/toolchain"
 )
 
 func main() {
@@ -36,6 +50,22 @@ func main() {
 	}
 	defer file.Close()
 
+	if dosReader, err := dos.NewReader(file); err == nil {
+		switch kind, _ := dosReader.Kind(); kind {
+		case dos.KindNE:
+			printNE(file)
+			return
+		case dos.KindLE, dos.KindLX:
+			printLE(file)
+			return
+		}
+	}
+
+	if teReader, err := te.NewReader(file); err == nil {
+		printTE(teReader)
+		return
+	}
+
 	start = time.Now()
 	reader, err := portableexecutable.NewReader(file)
 	elapsed += time.Since(start)
@@ -48,6 +78,31 @@ func main() {
 	fmt.Printf("Format: %s\n", reader.Format())
 	fmt.Printf("Subsystem: %s\n", reader.Subsystem())
 
+	if dosReader, err := dos.NewReader(file); err == nil {
+		if stub, err := dosReader.ReadStub(); err == nil {
+			fmt.Printf("DOS Stub: %s\n", stub.Kind())
+			fmt.Printf("  Address Range: %s (%d bytes)\n", stub.Location, stub.Location.Length)
+		}
+	}
+
+	if rich, err := reader.ReadRichHeader(); err == nil {
+		fmt.Printf("Rich Header (%d %s)\n", len(rich.Entries), plural(len(rich.Entries), "entry", "entries"))
+		fmt.Printf("  Address Range: %s (%d bytes)\n", rich.Location, rich.Location.Length)
+		fmt.Printf("  Checksum: 0x%08x (valid: %t)\n", rich.Key, rich.Valid())
+		for _, entry := range rich.Entries {
+			fmt.Printf("  %s\n", entry)
+		}
+	}
+
+	if toolchains, err := toolchain.Identify(reader); err == nil {
+		for _, tc := range toolchains {
+			fmt.Printf("Toolchain: %s\n", tc)
+			for _, evidence := range tc.Evidence {
+				fmt.Printf("  %s\n", evidence)
+			}
+		}
+	}
+
 	layout := reader.Layout()
 	{
 		table := layout.SymbolTable()
@@ -142,6 +197,259 @@ func main() {
 				}
 			}
 		}
+
+		if clr := dirs.Get(imagefile.CLRRuntimeHeaderID); !clr.IsZero() {
+			fmt.Printf("CLR Header\n")
+			summary, err := clrheader.Summarize(reader)
+			if err != nil {
+				fmt.Printf("  Failed to read the CLR header: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("  Kind: %s\n", summary.Kind)
+			fmt.Printf("  Platform: %s\n", summary.Platform())
+			fmt.Printf("  Version: %s\n", summary.MetadataVersion)
+			fmt.Printf("  CLR Header: %d.%d\n", summary.MajorRuntimeVersion, summary.MinorRuntimeVersion)
+			fmt.Printf("  CorFlags: 0x%x (%s)\n", uint32(summary.Flags), summary.Flags)
+
+			if clr, err := clrheader.NewReader(reader); err == nil {
+				if r2r, err := readytorun.NewReader(clr); err == nil {
+					header := r2r.Header()
+					fmt.Printf("  ReadyToRun Header: %d.%d\n", header.MajorVersion, header.MinorVersion)
+					fmt.Printf("    Flags: 0x%x (%s)\n", uint32(header.Flags), header.Flags)
+					if compiler, err := r2r.ReadCompilerIdentifier(); err == nil && compiler != "" {
+						fmt.Printf("    Compiler: %s\n", compiler)
+					}
+					if owner, err := r2r.ReadOwnerCompositeExecutable(); err == nil && owner != "" {
+						fmt.Printf("    Composite Image: %s\n", owner)
+					}
+					if functions, err := r2r.ReadRuntimeFunctions(); err == nil {
+						fmt.Printf("    Runtime Functions: %d\n", len(functions))
+					}
+					if imports, err := r2r.ReadImportSections(); err == nil {
+						fmt.Printf("    Import Sections: %d\n", len(imports))
+					}
+					fmt.Printf("    Sections (%d %s)\n", len(header.Sections), plural(len(header.Sections), "section", "sections"))
+					for _, section := range header.Sections {
+						fmt.Printf("      %-26s (File Range: %s, %d %s)\n", section.Type, section.Location, section.Address.Length, plural(section.Address.Length, "byte", "bytes"))
+					}
+				} else if err != readytorun.ErrMissingHeader {
+					fmt.Printf("  Failed to read the ReadyToRun header: %v\n", err)
+				}
+				if md, err := metadata.NewReader(clr); err != nil {
+					fmt.Printf("  Failed to read the metadata: %v\n", err)
+				} else {
+					if name, err := md.AssemblyName(); err == nil {
+						fmt.Printf("  Assembly: %s\n", name)
+					}
+					if err := strongname.Verify(clr); err == nil {
+						fmt.Printf("  Strong Name Signature: Valid\n")
+					} else if err != strongname.ErrNotSigned {
+						fmt.Printf("  Strong Name Signature: %v\n", err)
+					}
+					if framework, err := md.TargetFramework(); err == nil {
+						fmt.Printf("  Target Framework: %s\n", framework.Name)
+					}
+					if attributes, err := md.CustomAttributes(metadata.NewToken(metadata.TableAssembly, 1)); err == nil && len(attributes) > 0 {
+						fmt.Printf("  Assembly Attributes (%d %s)\n", len(attributes), plural(len(attributes), "attribute", "attributes"))
+						for _, attribute := range attributes {
+							if value, err := md.DecodeCustomAttribute(attribute, nil); err != nil {
+								fmt.Printf("    Failed to decode the attribute: %v\n", err)
+							} else {
+								fmt.Printf("    %s\n", value)
+							}
+						}
+					}
+					if refs, err := md.AssemblyReferences(); err == nil && len(refs) > 0 {
+						fmt.Printf("  Assembly References (%d %s)\n", len(refs), plural(len(refs), "reference", "references"))
+						for _, ref := range refs {
+							fmt.Printf("    %s\n", ref)
+						}
+					}
+					if imports, err := md.PInvokeImports(); err == nil && len(imports) > 0 {
+						fmt.Printf("  Platform Invoke Imports (%d %s)\n", len(imports), plural(len(imports), "import", "imports"))
+						for _, imp := range imports {
+							fmt.Printf("    %s!%s (%s)\n", imp.Module, imp.EntryPoint, imp.MethodName)
+						}
+					}
+					if manifest, err := md.ManifestResourceTable(); err == nil && len(manifest) > 0 {
+						header, _ := clr.ReadHeader()
+						fmt.Printf("  Managed Resources (%d %s)\n", len(manifest), plural(len(manifest), "resource", "resources"))
+						for _, resource := range manifest {
+							if !resource.Embedded() {
+								fmt.Printf("    %s (%s, external)\n", resource.Name, resource.Flags)
+								continue
+							}
+							data, err := clr.ReadResource(header, resource.Offset)
+							if err != nil {
+								fmt.Printf("    %s: Failed to read the resource: %v\n", resource.Name, err)
+								continue
+							}
+							fmt.Printf("    %s (%s, %d %s)\n", resource.Name, resource.Flags, len(data), plural(len(data), "byte", "bytes"))
+							if resources.HasSignature(data) {
+								printResourceSet(data)
+							}
+						}
+					}
+					tables := md.Tables()
+					fmt.Printf("  Metadata Tables (%d %s)\n", len(tables), plural(len(tables), "table", "tables"))
+					for _, table := range tables {
+						fmt.Printf("    %-22s %d %s\n", table.ID, table.Rows, plural(table.Rows, "row", "rows"))
+					}
+				}
+			}
+		}
+
+		if bundled, err := bundle.NewReader(reader); err != bundle.ErrMissingBundle {
+			fmt.Printf("Single-File Bundle\n")
+			if err != nil {
+				fmt.Printf("  Failed to read the bundle: %v\n", err)
+			} else {
+				header := bundled.Header()
+				fmt.Printf("  Header Offset: %s\n", bundled.Offset())
+				fmt.Printf("  Version: %d.%d\n", header.MajorVersion, header.MinorVersion)
+				fmt.Printf("  ID: %s\n", header.ID)
+				if header.MajorVersion >= 2 {
+					fmt.Printf("  Flags: 0x%x (%s)\n", uint64(header.Flags), header.Flags)
+				}
+				files := bundled.Files()
+				fmt.Printf("  Files (%d %s)\n", len(files), plural(len(files), "file", "files"))
+				for _, file := range files {
+					if file.Compressed {
+						fmt.Printf("    %s (%s, %d %s, %d compressed)\n", file.Path, file.Type, file.Size, plural(file.Size, "byte", "bytes"), file.Location.Length)
+					} else {
+						fmt.Printf("    %s (%s, %d %s)\n", file.Path, file.Type, file.Size, plural(file.Size, "byte", "bytes"))
+					}
+				}
+			}
+		}
+
+		if certificates := dirs.Get(imagefile.CertificateTableID); !certificates.IsZero() {
+			fmt.Printf("Authenticode Signatures\n")
+			start = time.Now()
+			signatures, err := authenticode.ReadSignatures(reader)
+			elapsed += time.Since(start)
+			if err != nil {
+				fmt.Printf("  Failed to read the authenticode signatures: %v\n", err)
+				os.Exit(1)
+			}
+			for _, signature := range signatures {
+				printSignature(1, signature)
+			}
+
+			if table, err := certificatetable.NewReader(reader); err == nil {
+				hidden, err := table.FindHiddenData()
+				if err != nil {
+					fmt.Printf("  Failed to check the certificate table for hidden data: %v\n", err)
+					os.Exit(1)
+				}
+				for _, data := range hidden {
+					fmt.Printf("  Hidden Data: %s\n", data)
+				}
+			}
+		}
+	}
+
+	if entries, err := sbat.Read(reader); err != sbat.ErrMissingSection {
+		fmt.Printf("SBAT Entries\n")
+		if err != nil {
+			fmt.Printf("  Failed to read the SBAT section: %v\n", err)
+		}
+		for _, entry := range entries {
+			fmt.Printf("  %s\n", entry)
+		}
+	}
+}
+
+func printNE(file *os.File) {
+	reader, err := ne.NewReader(file)
+	if err != nil {
+		fmt.Printf("Failed to read the NE header: %v\n", err)
+		os.Exit(1)
+	}
+	header := reader.Header()
+	fmt.Printf("Format: NE\n")
+	fmt.Printf("Operating System: %s\n", header.OperatingSystem())
+	if name, err := reader.ReadModuleName(); err == nil {
+		fmt.Printf("Module Name: %s\n", name)
+	}
+	if names, err := reader.ReadNonResidentNames(); err == nil && len(names) > 0 {
+		fmt.Printf("Module Description: %s\n", names[0].Name)
+	}
+	if segments, err := reader.ReadSegments(); err == nil {
+		fmt.Printf("Segment Table (%d %s)\n", len(segments), plural(len(segments), "segment", "segments"))
+		for i, segment := range segments {
+			kind := "Code"
+			if segment.Flags.IsData() {
+				kind = "Data"
+			}
+			fmt.Printf("  Segment %2d: %s (File Range: %s)\n", i+1, kind, segment.Location)
+		}
+	}
+	if modules, err := reader.ReadModuleReferences(); err == nil {
+		for _, module := range modules {
+			fmt.Printf("Module Reference: %s\n", module)
+		}
+	}
+	if resources, err := reader.ReadResources(); err == nil {
+		fmt.Printf("Resource Table (%d %s)\n", len(resources), plural(len(resources), "entry", "entries"))
+		for _, resource := range resources {
+			typ := resource.Type.String()
+			if resource.Type.IsNumeric() {
+				typ = resourcetype.ID(resource.Type.Number()).String()
+			}
+			fmt.Printf("  %s %s (File Range: %s)\n", typ, resource.ID, resource.Location)
+		}
+	}
+	if root, err := reader.ReadVersionInfo(); err == nil {
+		fmt.Printf("File Version Information\n")
+		printVersionRoot(root)
+	}
+}
+
+func printLE(file *os.File) {
+	reader, err := le.NewReader(file)
+	if err != nil {
+		fmt.Printf("Failed to read the linear executable header: %v\n", err)
+		os.Exit(1)
+	}
+	header := reader.Header()
+	fmt.Printf("Format: %s\n", header.Signature())
+	fmt.Printf("CPU: %s\n", header.CPU())
+	fmt.Printf("Operating System: %s\n", header.OperatingSystem())
+	fmt.Printf("Module Type: %s\n", header.Flags().ModuleType())
+	if name, err := reader.ReadModuleName(); err == nil {
+		fmt.Printf("Module Name: %s\n", name)
+	}
+	if objects, err := reader.ReadObjects(); err == nil {
+		fmt.Printf("Object Table (%d %s)\n", len(objects), plural(len(objects), "object", "objects"))
+		for i, object := range objects {
+			fmt.Printf("  Object %2d: Base 0x%08x, Size 0x%x, Flags 0x%04x\n", i+1, object.BaseAddress, object.VirtualSize, uint32(object.Flags))
+		}
+	}
+	if entries, err := reader.ReadEntries(); err == nil {
+		fmt.Printf("Entry Table (%d %s)\n", len(entries), plural(len(entries), "entry", "entries"))
+		for _, entry := range entries {
+			fmt.Printf("  Ordinal %d: %s (Object %d, Offset 0x%x)\n", entry.Ordinal, entry.Type, entry.Object, entry.Offset)
+		}
+	}
+}
+
+func printTE(reader *te.Reader) {
+	fmt.Printf("Format: TE\n")
+	fmt.Printf("Machine: %s\n", reader.Machine())
+	fmt.Printf("Subsystem: %s\n", reader.Subsystem())
+	fmt.Printf("Stripped Size: %d\n", reader.StrippedSize())
+	fmt.Printf("Image Base: 0x%x\n", reader.ImageBase())
+	fmt.Printf("Entry Point: %s\n", reader.EntryPoint())
+	sections := reader.Sections()
+	fmt.Printf("Sections (%d %s)\n", len(sections), plural(len(sections), "section", "sections"))
+	for _, section := range sections {
+		fmt.Printf("  %-8s Virtual %s, File %s\n", section.Name, section.RelativeVirtualAddressRange, section.FileRange)
+	}
+	for _, id := range []imagefile.DirectoryID{imagefile.BaseRelocationTableID, imagefile.DebugID} {
+		if dir := reader.DataDirectories().Get(id); !dir.IsZero() {
+			fmt.Printf("%s: %s\n", id, dir.Location)
+		}
 	}
 }
 
@@ -164,11 +472,40 @@ func printResourceDirectory(reader *resourcedirectory.Reader, depth int, table r
 	}
 }
 
+func printResourceSet(data []byte) {
+	set, err := resources.Parse(data)
+	if err != nil {
+		fmt.Printf("      Failed to parse the resources: %v\n", err)
+		return
+	}
+	for _, entry := range set.Entries {
+		switch value := entry.Value.(type) {
+		case string:
+			if runes := []rune(value); len(runes) > 60 {
+				value = string(runes[:57]) + "..."
+			}
+			fmt.Printf("      %s (%s): %q\n", entry.Name, entry.Type, value)
+		case []byte:
+			name := entry.Type.String()
+			if entry.TypeName != "" {
+				name = entry.TypeName
+			}
+			fmt.Printf("      %s (%s): %d %s\n", entry.Name, name, len(value), plural(len(value), "byte", "bytes"))
+		default:
+			fmt.Printf("      %s (%s): %v\n", entry.Name, entry.Type, value)
+		}
+	}
+}
+
 func printVersionInfo(data []byte) {
 	root, err := versioninfo.NewRoot(data)
 	if err != nil {
 		fmt.Printf("      Failed to collect file version information: %v\n", err)
 	}
+	printVersionRoot(root)
+}
+
+func printVersionRoot(root versioninfo.Root) {
 	info := root.FileInfo()
 	if info.Valid() {
 		fmt.Printf("      File Version: %s\n", info.FileVersion())
@@ -202,3 +539,20 @@ func printVersionInfoTree(depth int, node versioninfo.Node) {
 		printVersionInfoTree(depth+1, node)
 	}
 }
+
+func printSignature(depth int, signature authenticode.Signature) {
+	indent := strings.Repeat("  ", depth)
+	fmt.Printf("%sSignature (%s): %x\n", indent, signature.DigestAlgorithm, signature.Digest)
+	if cert := signature.Signer.Certificate; cert != nil {
+		fmt.Printf("%s  Signer: %s\n", indent, cert.Subject)
+	}
+	if table := signature.PageHashes; !table.IsZero() {
+		fmt.Printf("%s  Page Hashes (%s): %d %s\n", indent, table.Algorithm, len(table.Entries)-1, plural(len(table.Entries)-1, "page", "pages"))
+	}
+	for _, timestamp := range signature.Signer.Timestamps {
+		fmt.Printf("%s  Timestamp (%s, %s): %s\n", indent, timestamp.Format, timestamp.DigestAlgorithm, timestamp.Time)
+	}
+	for _, nested := range signature.Nested {
+		printSignature(depth+1, nested)
+	}
+}
@@ -1,6 +1,6 @@
 package main
 
-func plural[T ~uint | ~int](value T, singular, plural string) string {
+func plural[T ~uint | ~int | ~uint32 | ~uint64](value T, singular, plural string) string {
 	if value == 1 {
 		return singular
 	}
@@ -0,0 +1,61 @@
+package coff
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Sizes of the structures within extended object files.
+const (
+	bigObjHeaderSize = 56 // ANON_OBJECT_HEADER_BIGOBJ
+	bigObjSymbolSize = 20 // IMAGE_SYMBOL_EX
+)
+
+// maxBigObjSections limits the number of sections that will be read from an
+// extended object file.
+const maxBigObjSections = 1 << 24
+
+// bigObjClassID is the class ID that identifies an anonymous object header
+// as the header of an extended object file.
+//
+// {D1BAA1C7-BAEE-4BA9-AF20-FAF66AA4DCB8}
+var bigObjClassID = []byte{
+	0xC7, 0xA1, 0xBA, 0xD1, 0xEE, 0xBA, 0xA9, 0x4B,
+	0xAF, 0x20, 0xFA, 0xF6, 0x6A, 0xA4, 0xDC, 0xB8,
+}
+
+// bigObjHeader is the header of an extended object file. It is the
+// ANON_OBJECT_HEADER_BIGOBJ structure.
+type bigObjHeader [bigObjHeaderSize]byte
+
+// Valid returns true if the header is an anonymous object header with the
+// version and class ID of an extended object file.
+func (header *bigObjHeader) Valid() bool {
+	return isAnonymous(imagefile.FileHeader(header[:])) && header.Version() >= 2 && bytes.Equal(header[12:28], bigObjClassID)
+}
+
+func (header *bigObjHeader) Version() uint16 {
+	return binary.LittleEndian.Uint16(header[4:6])
+}
+
+func (header *bigObjHeader) Machine() imagefile.Machine {
+	return imagefile.Machine(binary.LittleEndian.Uint16(header[6:8]))
+}
+
+func (header *bigObjHeader) TimeDateStamp() uint32 {
+	return binary.LittleEndian.Uint32(header[8:12])
+}
+
+func (header *bigObjHeader) NumberOfSections() uint32 {
+	return binary.LittleEndian.Uint32(header[44:48])
+}
+
+func (header *bigObjHeader) PointerToSymbolTable() imagefile.FileOffset {
+	return imagefile.FileOffset(binary.LittleEndian.Uint32(header[48:52]))
+}
+
+func (header *bigObjHeader) NumberOfSymbols() uint32 {
+	return binary.LittleEndian.Uint32(header[52:56])
+}
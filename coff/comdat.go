@@ -0,0 +1,154 @@
+package coff
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// ComdatSelection determines how the linker resolves multiple definitions
+// of a COMDAT section.
+type ComdatSelection uint8
+
+// COMDAT selection rules.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#comdat-sections-object-only
+const (
+	SelectNoDuplicates ComdatSelection = 1 // IMAGE_COMDAT_SELECT_NODUPLICATES, Duplicate definitions are an error
+	SelectAny          ComdatSelection = 2 // IMAGE_COMDAT_SELECT_ANY, Any of the definitions is selected
+	SelectSameSize     ComdatSelection = 3 // IMAGE_COMDAT_SELECT_SAME_SIZE, The definitions must have the same size
+	SelectExactMatch   ComdatSelection = 4 // IMAGE_COMDAT_SELECT_EXACT_MATCH, The definitions must be identical
+	SelectAssociative  ComdatSelection = 5 // IMAGE_COMDAT_SELECT_ASSOCIATIVE, The section is linked if its associated section is linked
+	SelectLargest      ComdatSelection = 6 // IMAGE_COMDAT_SELECT_LARGEST, The largest definition is selected
+	SelectNewest       ComdatSelection = 7 // IMAGE_COMDAT_SELECT_NEWEST, The newest definition is selected
+)
+
+// String returns a string representation of the selection rule.
+func (selection ComdatSelection) String() string {
+	switch selection {
+	case SelectNoDuplicates:
+		return "NoDuplicates"
+	case SelectAny:
+		return "Any"
+	case SelectSameSize:
+		return "SameSize"
+	case SelectExactMatch:
+		return "ExactMatch"
+	case SelectAssociative:
+		return "Associative"
+	case SelectLargest:
+		return "Largest"
+	case SelectNewest:
+		return "Newest"
+	default:
+		return fmt.Sprintf("<unrecognized COMDAT selection: %d>", uint8(selection))
+	}
+}
+
+// Comdat is a group of COMDAT sections that the linker keeps or discards
+// together. It is made up of a leader section that is identified by its
+// COMDAT symbol, and the sections that are associated with it.
+type Comdat struct {
+	// Section is the 1-based number of the leader section.
+	Section SectionNumber
+
+	// Symbol is the COMDAT symbol that identifies the leader section. Its
+	// name is used to detect duplicate definitions.
+	Symbol Symbol
+
+	// Selection is the selection rule of the leader section.
+	Selection ComdatSelection
+
+	// Associated holds the 1-based numbers of the sections that are
+	// associated with the leader section. Sections that are associated
+	// with other associated sections are included.
+	Associated []SectionNumber
+}
+
+// String returns a string representation of the COMDAT group.
+func (comdat Comdat) String() string {
+	return fmt.Sprintf("%s (section %s, %s, %d associated)", comdat.Symbol.Name, comdat.Section, comdat.Selection, len(comdat.Associated))
+}
+
+// collectComdats groups the COMDAT sections of an object file using the
+// section definitions in its symbol table.
+//
+// The section definition symbol of each COMDAT section is followed by its
+// COMDAT symbol, which is the first symbol defined in the section. The
+// COMDAT symbol of an associative section is not significant.
+func collectComdats(sections []Section, symbols SymbolTable) ([]Comdat, error) {
+	var comdats []Comdat
+	leaders := make(map[SectionNumber]int)                // Section number to index in comdats
+	associations := make(map[SectionNumber]SectionNumber) // Associative section to its target
+	pending := make(map[SectionNumber]ComdatSelection)    // Leaders waiting for their COMDAT symbol
+
+	for _, sym := range symbols {
+		number := sym.SectionNumber
+		if number <= 0 || int(number) > len(sections) {
+			continue
+		}
+		if sections[number-1].Characteristics&imagefile.SectionLinkCOMDAT == 0 {
+			continue
+		}
+		if def, ok := sym.SectionDefinition(); ok {
+			if _, seen := pending[number]; seen {
+				continue
+			}
+			if _, seen := leaders[number]; seen {
+				continue
+			}
+			if def.Selection == SelectAssociative {
+				associations[number] = def.Number
+			} else {
+				pending[number] = def.Selection
+			}
+			continue
+		}
+		if selection, ok := pending[number]; ok {
+			delete(pending, number)
+			leaders[number] = len(comdats)
+			comdats = append(comdats, Comdat{
+				Section:   number,
+				Symbol:    sym,
+				Selection: selection,
+			})
+		}
+	}
+
+	if len(pending) > 0 {
+		// Report the lowest section number so that the error is stable.
+		numbers := slices.Sorted(maps.Keys(pending))
+		return nil, fmt.Errorf("the COMDAT section %s does not have a COMDAT symbol", numbers[0])
+	}
+
+	// Attach each associative section to the leader at the end of its
+	// chain of associations.
+	for i := range sections {
+		number := SectionNumber(i + 1)
+		target, ok := associations[number]
+		if !ok {
+			continue
+		}
+		for hops := 0; ; hops++ {
+			next, ok := associations[target]
+			if !ok {
+				break
+			}
+			if hops > len(associations) {
+				return nil, fmt.Errorf("the associative COMDAT section %s has a circular association", number)
+			}
+			target = next
+		}
+		// Sections may also be associated with sections that are not
+		// COMDATs, in which case they don't belong to a group.
+		index, ok := leaders[target]
+		if !ok {
+			continue
+		}
+		comdats[index].Associated = append(comdats[index].Associated, number)
+	}
+
+	return comdats, nil
+}
@@ -0,0 +1,181 @@
+package coff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// importHeaderSize is the size of a short import object header.
+const importHeaderSize = 20
+
+// ImportType identifies the kind of symbol that a short import object
+// imports.
+type ImportType uint8
+
+// Import types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#import-type
+const (
+	ImportCode  ImportType = 0 // IMPORT_OBJECT_CODE, Executable code
+	ImportData  ImportType = 1 // IMPORT_OBJECT_DATA, Data
+	ImportConst ImportType = 2 // IMPORT_OBJECT_CONST, Data declared as const
+)
+
+// String returns a string representation of the import type.
+func (t ImportType) String() string {
+	switch t {
+	case ImportCode:
+		return "Code"
+	case ImportData:
+		return "Data"
+	case ImportConst:
+		return "Const"
+	default:
+		return fmt.Sprintf("<unrecognized import type: %d>", uint8(t))
+	}
+}
+
+// ImportNameType determines how the name that is looked up in the DLL's
+// export table is derived from the symbol of a short import object.
+type ImportNameType uint8
+
+// Import name types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#import-name-type
+const (
+	ImportByOrdinal      ImportNameType = 0 // IMPORT_OBJECT_ORDINAL, The import is by ordinal
+	ImportName           ImportNameType = 1 // IMPORT_OBJECT_NAME, The import name is the symbol name
+	ImportNameNoPrefix   ImportNameType = 2 // IMPORT_OBJECT_NAME_NO_PREFIX, The import name is the symbol name without its leading ?, @ or _
+	ImportNameUndecorate ImportNameType = 3 // IMPORT_OBJECT_NAME_UNDECORATE, The import name is the symbol name without its prefix and anything after the first @
+	ImportNameExportAs   ImportNameType = 4 // IMPORT_OBJECT_NAME_EXPORTAS, The import name is stored after the DLL name
+)
+
+// String returns a string representation of the import name type.
+func (t ImportNameType) String() string {
+	switch t {
+	case ImportByOrdinal:
+		return "Ordinal"
+	case ImportName:
+		return "Name"
+	case ImportNameNoPrefix:
+		return "NoPrefix"
+	case ImportNameUndecorate:
+		return "Undecorate"
+	case ImportNameExportAs:
+		return "ExportAs"
+	default:
+		return fmt.Sprintf("<unrecognized import name type: %d>", uint8(t))
+	}
+}
+
+// ImportObject is a short import object, which describes a single symbol
+// imported from a DLL. Import libraries are made up of these objects.
+type ImportObject struct {
+	Machine       imagefile.Machine
+	TimeDateStamp uint32
+
+	// Ordinal is the ordinal of the import if it is imported by ordinal.
+	// Otherwise it is a hint for the index of the import name in the DLL's
+	// export name table.
+	Ordinal uint16
+
+	// Type is the kind of symbol that is imported.
+	Type ImportType
+
+	// NameType determines how the import name is derived from the symbol.
+	NameType ImportNameType
+
+	// Symbol is the name of the symbol that the import object defines.
+	Symbol string
+
+	// DLL is the name of the DLL that the symbol is imported from.
+	DLL string
+
+	// ExportName is the import name that is stored explicitly when the
+	// name type is [ImportNameExportAs].
+	ExportName string
+}
+
+// ByOrdinal returns true if the symbol is imported by ordinal.
+func (imp ImportObject) ByOrdinal() bool {
+	return imp.NameType == ImportByOrdinal
+}
+
+// ImportName returns the name that is looked up in the DLL's export table.
+// It returns an empty string if the symbol is imported by ordinal.
+func (imp ImportObject) ImportName() string {
+	switch imp.NameType {
+	case ImportByOrdinal:
+		return ""
+	case ImportNameNoPrefix:
+		return trimImportPrefix(imp.Symbol)
+	case ImportNameUndecorate:
+		name := trimImportPrefix(imp.Symbol)
+		if cutoff := strings.IndexByte(name, '@'); cutoff > 0 {
+			name = name[:cutoff]
+		}
+		return name
+	case ImportNameExportAs:
+		return imp.ExportName
+	default:
+		return imp.Symbol
+	}
+}
+
+// String returns a string representation of the import object.
+func (imp ImportObject) String() string {
+	if imp.ByOrdinal() {
+		return fmt.Sprintf("%s!#%d (%s, %s)", imp.DLL, imp.Ordinal, imp.Symbol, imp.Type)
+	}
+	return fmt.Sprintf("%s!%s (%s, %s)", imp.DLL, imp.ImportName(), imp.Symbol, imp.Type)
+}
+
+// trimImportPrefix removes the leading ?, @ or _ from an import symbol.
+func trimImportPrefix(name string) string {
+	if len(name) > 0 && (name[0] == '?' || name[0] == '@' || name[0] == '_') {
+		return name[1:]
+	}
+	return name
+}
+
+// IsImportObject returns true if the given data starts with a short import
+// object header.
+func IsImportObject(data []byte) bool {
+	return len(data) >= importHeaderSize && isAnonymous(imagefile.FileHeader(data)) && binary.LittleEndian.Uint16(data[4:6]) == 0
+}
+
+// ParseImportObject parses the given data as a short import object, which
+// starts with an IMPORT_OBJECT_HEADER structure.
+func ParseImportObject(data []byte) (ImportObject, error) {
+	if !IsImportObject(data) {
+		return ImportObject{}, fmt.Errorf("the data does not start with a short import object header")
+	}
+
+	size := binary.LittleEndian.Uint32(data[12:16])
+	if uint64(size) > uint64(len(data)-importHeaderSize) {
+		return ImportObject{}, fmt.Errorf("the short import object has a data size of %d bytes, which exceeds the %d bytes available", size, len(data)-importHeaderSize)
+	}
+	flags := binary.LittleEndian.Uint16(data[18:20])
+	imp := ImportObject{
+		Machine:       imagefile.Machine(binary.LittleEndian.Uint16(data[6:8])),
+		TimeDateStamp: binary.LittleEndian.Uint32(data[8:12]),
+		Ordinal:       binary.LittleEndian.Uint16(data[16:18]),
+		Type:          ImportType(flags & 0x3),
+		NameType:      ImportNameType((flags >> 2) & 0x7),
+	}
+
+	strs := bytes.Split(data[importHeaderSize:importHeaderSize+size], []byte{0})
+	if len(strs) < 2 {
+		return ImportObject{}, fmt.Errorf("the short import object does not have null-terminated symbol and DLL names")
+	}
+	imp.Symbol = string(strs[0])
+	imp.DLL = string(strs[1])
+	if imp.NameType == ImportNameExportAs && len(strs) > 2 {
+		imp.ExportName = string(strs[2])
+	}
+	return imp, nil
+}
@@ -0,0 +1,401 @@
+package coff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// ErrAnonymousObject is returned by [NewReader] if the file starts with an
+// anonymous object header that does not belong to an extended object file.
+// Anonymous object headers are also used by short import objects and by
+// objects that hold intermediate code for link-time code generation.
+var ErrAnonymousObject = errors.New("the file is an anonymous object rather than a COFF object file")
+
+// maxStringTableSize limits the size of string table that will be read.
+const maxStringTableSize = 64 << 20
+
+// Reader reads COFF object file (.obj) data from an underlying
+// [io.ReaderAt].
+type Reader struct {
+	source io.ReaderAt
+
+	bigobj          bool
+	symbolSize      uint
+	machine         imagefile.Machine
+	timeDateStamp   uint32
+	characteristics uint16
+	sections        []Section
+	symbolTable     imagefile.FileOffset
+	numberOfSymbols uint32
+	strings         []byte
+}
+
+// NewReader creates and initializes a new COFF object file [Reader] that
+// reads from source.
+//
+// It reads and validates the file header, the section table and the
+// string table. If the validation fails it returns an error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	r := &Reader{source: source}
+
+	// Read the file header.
+	header := make(imagefile.FileHeader, imagefile.FileHeaderSize)
+	if _, err := source.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("failed to read the COFF file header: %w", err)
+	}
+	if isAnonymous(header) {
+		return newBigObjReader(source)
+	}
+	r.machine = header.Machine()
+	if !r.machine.Known() {
+		return nil, fmt.Errorf("the file does not have a COFF file header with a recognized machine type: %s", r.machine)
+	}
+	r.symbolSize = imagefile.SymbolSize
+	r.timeDateStamp = header.TimeDateStamp()
+	r.characteristics = header.Characteristics()
+	r.symbolTable = header.PointerToSymbolTable()
+	r.numberOfSymbols = header.NumberOfSymbols()
+
+	// Read the string table, which immediately follows the symbol table.
+	if err := r.readStringTable(); err != nil {
+		return nil, err
+	}
+
+	// Read the section table.
+	start := imagefile.FileOffset(imagefile.FileHeaderSize + uint(header.SizeOfOptionalHeader()))
+	if err := r.readSectionTable(start, int(header.NumberOfSections())); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// newBigObjReader creates and initializes a new COFF object file [Reader]
+// for an extended object file, which starts with an anonymous object
+// header. If the header belongs to some other kind of anonymous object it
+// returns [ErrAnonymousObject].
+func newBigObjReader(source io.ReaderAt) (*Reader, error) {
+	var header bigObjHeader
+	if _, err := source.ReadAt(header[:], 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrAnonymousObject
+		}
+		return nil, fmt.Errorf("failed to read the anonymous object header: %w", err)
+	}
+	if !header.Valid() {
+		return nil, ErrAnonymousObject
+	}
+
+	r := &Reader{
+		source:          source,
+		bigobj:          true,
+		symbolSize:      bigObjSymbolSize,
+		machine:         header.Machine(),
+		timeDateStamp:   header.TimeDateStamp(),
+		symbolTable:     header.PointerToSymbolTable(),
+		numberOfSymbols: header.NumberOfSymbols(),
+	}
+
+	if err := r.readStringTable(); err != nil {
+		return nil, err
+	}
+
+	count := header.NumberOfSections()
+	if count > maxBigObjSections {
+		return nil, fmt.Errorf("the extended object file has %d sections, which exceeds the maximum of %d", count, maxBigObjSections)
+	}
+	if err := r.readSectionTable(bigObjHeaderSize, int(count)); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// IsBigObj returns true if the object file uses the extended object file
+// format produced by the /bigobj compiler option, which supports more
+// sections than the standard format.
+func (r *Reader) IsBigObj() bool {
+	return r.bigobj
+}
+
+// Machine returns the machine that the object file is targeting.
+func (r *Reader) Machine() imagefile.Machine {
+	return r.machine
+}
+
+// TimeDateStamp returns the time at which the object file was created, as
+// the number of seconds since the Unix epoch. Many compilers write zero.
+func (r *Reader) TimeDateStamp() uint32 {
+	return r.timeDateStamp
+}
+
+// Characteristics returns the flags of the COFF file header. Extended
+// object files do not have characteristics.
+func (r *Reader) Characteristics() uint16 {
+	return r.characteristics
+}
+
+// Sections returns the sections of the object file.
+func (r *Reader) Sections() []Section {
+	return r.sections
+}
+
+// Section returns the section with the given 1-based section number.
+func (r *Reader) Section(number SectionNumber) (Section, bool) {
+	if number <= 0 || int(number) > len(r.sections) {
+		return Section{}, false
+	}
+	return r.sections[number-1], true
+}
+
+// ReadSectionData reads the raw data of the given section. Sections that
+// hold uninitialized data have no raw data.
+func (r *Reader) ReadSectionData(section Section) ([]byte, error) {
+	if section.Location.Length == 0 {
+		return []byte{}, nil
+	}
+	data, err := r.readRange(section.Location.Start, section.Location.Length)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the data of section %s (%s) at location %s: %w", section.Number, section.Name, section.Location, err)
+	}
+	return data, nil
+}
+
+// ReadRelocations reads the relocation entries of the given section.
+//
+// Sections with more than 65535 relocations store the actual count in the
+// first entry, which is omitted from the returned entries.
+func (r *Reader) ReadRelocations(section Section) ([]Relocation, error) {
+	count := uint(section.NumberOfRelocations)
+	start := section.Relocations
+	extended := section.Characteristics&imagefile.SectionExtendedRelocations != 0 && count == 0xFFFF
+	if extended {
+		var first [relocationSize]byte
+		if _, err := r.source.ReadAt(first[:], int64(start)); err != nil {
+			return nil, fmt.Errorf("failed to read the extended relocation count of section %s (%s): %w", section.Number, section.Name, err)
+		}
+		count = uint(relocationEntry(first[:]).VirtualAddress())
+		if count == 0 {
+			return nil, fmt.Errorf("the section %s (%s) has an invalid extended relocation count", section.Number, section.Name)
+		}
+		count--
+		start += relocationSize
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	data, err := r.readRange(start, count*relocationSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the relocations of section %s (%s) at offset %s: %w", section.Number, section.Name, start, err)
+	}
+
+	relocations := make([]Relocation, count)
+	for i := range relocations {
+		entry := relocationEntry(data[i*relocationSize : (i+1)*relocationSize])
+		relocations[i] = Relocation{
+			Offset:      entry.VirtualAddress(),
+			SymbolIndex: entry.SymbolTableIndex(),
+			Type:        entry.Type(),
+		}
+	}
+	return relocations, nil
+}
+
+// ReadSymbols reads the symbol table. Auxiliary records are attached to
+// the symbols that they follow.
+func (r *Reader) ReadSymbols() (SymbolTable, error) {
+	size := r.symbolSize
+	if r.numberOfSymbols == 0 {
+		return nil, nil
+	}
+
+	data, err := r.readRange(r.symbolTable, uint(r.numberOfSymbols)*size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the symbol table at offset %s: %w", r.symbolTable, err)
+	}
+
+	var symbols SymbolTable
+	for index := uint32(0); index < r.numberOfSymbols; {
+		record := data[uint(index)*size : uint(index+1)*size]
+		name, err := r.symbolName(record[0:8])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the name of symbol %d: %w", index, err)
+		}
+		sym := Symbol{
+			Index: index,
+			Name:  name,
+			Value: binary.LittleEndian.Uint32(record[8:12]),
+		}
+		if r.bigobj {
+			// The section number is two bytes wider, which shifts the
+			// fields that follow it.
+			sym.SectionNumber = SectionNumber(int32(binary.LittleEndian.Uint32(record[12:16])))
+			record = record[2:]
+		} else {
+			sym.SectionNumber = SectionNumber(int16(binary.LittleEndian.Uint16(record[12:14])))
+		}
+		sym.Type = binary.LittleEndian.Uint16(record[14:16])
+		sym.StorageClass = StorageClass(record[16])
+		aux := uint32(record[17])
+		if index+1+aux > r.numberOfSymbols {
+			return nil, fmt.Errorf("the auxiliary records of symbol %d exceed the bounds of the symbol table", index)
+		}
+		for i := range aux {
+			start := uint(index+1+i) * size
+			sym.Aux = append(sym.Aux, data[start:start+size])
+		}
+		symbols = append(symbols, sym)
+		index += 1 + aux
+	}
+	return symbols, nil
+}
+
+// ReadComdats reads the symbol table and returns the COMDAT groups of the
+// object file.
+func (r *Reader) ReadComdats() ([]Comdat, error) {
+	symbols, err := r.ReadSymbols()
+	if err != nil {
+		return nil, err
+	}
+	return collectComdats(r.sections, symbols)
+}
+
+// ReadString returns the string at the given offset within the string
+// table. Offsets include the 4 byte size field at the start of the table.
+func (r *Reader) ReadString(offset imagefile.StringOffset) (string, error) {
+	if offset < 4 || uint(offset) >= uint(len(r.strings)) {
+		return "", fmt.Errorf("the string offset %d exceeds the %d byte length of the COFF string table", offset, len(r.strings))
+	}
+	data := r.strings[offset:]
+	if cutoff := bytes.IndexByte(data, 0); cutoff >= 0 {
+		data = data[:cutoff]
+	}
+	return string(data), nil
+}
+
+// readRange reads length bytes of data at the given offset. The length
+// comes from the file's headers, so the data is read incrementally instead
+// of allocating the declared length up front. If fewer bytes are available
+// it returns [io.ErrUnexpectedEOF].
+func (r *Reader) readRange(offset imagefile.FileOffset, length uint) ([]byte, error) {
+	data, err := io.ReadAll(io.NewSectionReader(r.source, int64(offset), int64(length)))
+	if err == nil && uint(len(data)) < length {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readStringTable reads the string table that follows the symbol table.
+func (r *Reader) readStringTable() error {
+	if r.symbolTable == 0 {
+		return nil
+	}
+	start := r.symbolTable + imagefile.FileOffset(uint(r.numberOfSymbols)*r.symbolSize)
+
+	var size [4]byte
+	if n, err := r.source.ReadAt(size[:], int64(start)); err != nil {
+		// Some producers omit the string table when it would be empty.
+		if n == 0 && errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the size of the COFF string table at offset %s: %w", start, err)
+	}
+	length := binary.LittleEndian.Uint32(size[:])
+	if length <= 4 {
+		return nil
+	}
+	if length > maxStringTableSize {
+		return fmt.Errorf("the COFF string table has a length of %d bytes, which exceeds the maximum of %d bytes", length, maxStringTableSize)
+	}
+
+	r.strings = make([]byte, length)
+	if _, err := r.source.ReadAt(r.strings, int64(start)); err != nil {
+		return fmt.Errorf("failed to read the COFF string table at offset %s: %w", start, err)
+	}
+	return nil
+}
+
+// readSectionTable reads count section headers starting at the given file
+// offset.
+func (r *Reader) readSectionTable(start imagefile.FileOffset, count int) error {
+	data := make([]byte, count*imagefile.SectionHeaderSize)
+	if _, err := r.source.ReadAt(data, int64(start)); err != nil {
+		return fmt.Errorf("failed to read the COFF section table: %w", err)
+	}
+
+	r.sections = make([]Section, count)
+	for i := range r.sections {
+		header := imagefile.SectionHeader(data[i*imagefile.SectionHeaderSize : (i+1)*imagefile.SectionHeaderSize])
+		name, err := r.sectionName(header.Name())
+		if err != nil {
+			return fmt.Errorf("failed to read the name of section %d: %w", i+1, err)
+		}
+		section := Section{
+			Number:              SectionNumber(i + 1),
+			Name:                name,
+			Relocations:         header.PointerToRelocations(),
+			NumberOfRelocations: header.NumberOfRelocations(),
+			Characteristics:     header.Characteristics(),
+		}
+		if section.Characteristics&imagefile.SectionUninitializedData == 0 {
+			section.Location = imagefile.FileRange{
+				Start:  header.PointerToRawData(),
+				Length: header.SizeOfRawData(),
+			}
+		}
+		r.sections[i] = section
+	}
+	return nil
+}
+
+// sectionName resolves the given section name. Names that exceed eight
+// characters refer to the string table, either as a decimal offset in the
+// form "/123" or as a base64 offset in the form "//AAAAAA".
+func (r *Reader) sectionName(name imagefile.SectionName) (string, error) {
+	if encoded, ok := strings.CutPrefix(string(name), "//"); ok {
+		var offset uint64
+		for _, c := range encoded {
+			value := strings.IndexRune(base64Alphabet, c)
+			if value < 0 {
+				return "", fmt.Errorf("the section name %q has an invalid base64 string table offset", name)
+			}
+			offset = offset<<6 | uint64(value)
+		}
+		return r.ReadString(imagefile.StringOffset(offset))
+	}
+	if isReference, offset := name.Reference(); isReference {
+		return r.ReadString(offset)
+	}
+	return string(name), nil
+}
+
+// base64Alphabet is the alphabet used to encode large string table offsets
+// in section names.
+const base64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// symbolName resolves the given 8 byte symbol name field.
+func (r *Reader) symbolName(field []byte) (string, error) {
+	if binary.LittleEndian.Uint32(field[0:4]) == 0 {
+		return r.ReadString(imagefile.StringOffset(binary.LittleEndian.Uint32(field[4:8])))
+	}
+	if cutoff := bytes.IndexByte(field, 0); cutoff >= 0 {
+		field = field[:cutoff]
+	}
+	return string(field), nil
+}
+
+// isAnonymous returns true if the given file header is an anonymous object
+// header, which starts with a zero machine type and 0xFFFF section count.
+func isAnonymous(header imagefile.FileHeader) bool {
+	return header.Machine() == imagefile.MachineUnknown && header.NumberOfSections() == 0xFFFF
+}
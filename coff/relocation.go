@@ -0,0 +1,44 @@
+package coff
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// relocationSize is the size of a COFF relocation entry.
+const relocationSize = 10
+
+// Relocation is a COFF relocation entry, which identifies a location within
+// a section that must be adjusted by the linker.
+type Relocation struct {
+	// Offset is the offset of the location from the start of the section.
+	Offset uint32
+
+	// SymbolIndex is the symbol table index of the symbol that the
+	// location refers to.
+	SymbolIndex uint32
+
+	// Type identifies how the location is adjusted. Its meaning depends
+	// on the machine type of the object file.
+	Type RelocationType
+}
+
+// String returns a string representation of the relocation.
+func (rel Relocation) String() string {
+	return fmt.Sprintf("0x%08x type 0x%04x symbol %d", rel.Offset, uint16(rel.Type), rel.SymbolIndex)
+}
+
+// relocationEntry is a COFF relocation entry.
+type relocationEntry []byte
+
+func (entry relocationEntry) VirtualAddress() uint32 {
+	return binary.LittleEndian.Uint32(entry[0:4])
+}
+
+func (entry relocationEntry) SymbolTableIndex() uint32 {
+	return binary.LittleEndian.Uint32(entry[4:8])
+}
+
+func (entry relocationEntry) Type() RelocationType {
+	return RelocationType(binary.LittleEndian.Uint16(entry[8:10]))
+}
@@ -0,0 +1,198 @@
+package coff
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// RelocationType identifies the type of a COFF relocation. The meaning of
+// each type depends on the machine type of the object file.
+type RelocationType uint16
+
+// Name returns the name of the relocation type for the given machine, as
+// defined by the PE/COFF specification. If the type is not recognized it
+// returns a string holding its numeric value.
+func (t RelocationType) Name(machine imagefile.Machine) string {
+	var names map[RelocationType]string
+	switch machine {
+	case imagefile.MachineAMD64:
+		names = relocationNamesAMD64
+	case imagefile.MachineX86:
+		names = relocationNamesX86
+	case imagefile.MachineARM, imagefile.MachineARMNT, imagefile.MachineThumb:
+		names = relocationNamesARM
+	case imagefile.MachineARM64, imagefile.MachineARM64EC, imagefile.MachineARM64X:
+		names = relocationNamesARM64
+	}
+	if name, ok := names[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("<unrecognized %s relocation type: %x>", machine, uint16(t))
+}
+
+// AMD64 relocation types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#type-indicators
+const (
+	RelocationAMD64Absolute RelocationType = 0x0000 // IMAGE_REL_AMD64_ABSOLUTE, The relocation is ignored
+	RelocationAMD64Addr64   RelocationType = 0x0001 // IMAGE_REL_AMD64_ADDR64, The 64-bit VA of the target
+	RelocationAMD64Addr32   RelocationType = 0x0002 // IMAGE_REL_AMD64_ADDR32, The 32-bit VA of the target
+	RelocationAMD64Addr32NB RelocationType = 0x0003 // IMAGE_REL_AMD64_ADDR32NB, The 32-bit address without an image base (RVA)
+	RelocationAMD64Rel32    RelocationType = 0x0004 // IMAGE_REL_AMD64_REL32, The 32-bit address relative to the byte following the relocation
+	RelocationAMD64Rel32_1  RelocationType = 0x0005 // IMAGE_REL_AMD64_REL32_1, The 32-bit address relative to byte distance 1 from the relocation
+	RelocationAMD64Rel32_2  RelocationType = 0x0006 // IMAGE_REL_AMD64_REL32_2, The 32-bit address relative to byte distance 2 from the relocation
+	RelocationAMD64Rel32_3  RelocationType = 0x0007 // IMAGE_REL_AMD64_REL32_3, The 32-bit address relative to byte distance 3 from the relocation
+	RelocationAMD64Rel32_4  RelocationType = 0x0008 // IMAGE_REL_AMD64_REL32_4, The 32-bit address relative to byte distance 4 from the relocation
+	RelocationAMD64Rel32_5  RelocationType = 0x0009 // IMAGE_REL_AMD64_REL32_5, The 32-bit address relative to byte distance 5 from the relocation
+	RelocationAMD64Section  RelocationType = 0x000A // IMAGE_REL_AMD64_SECTION, The 16-bit section index of the section that contains the target
+	RelocationAMD64SecRel   RelocationType = 0x000B // IMAGE_REL_AMD64_SECREL, The 32-bit offset of the target from the beginning of its section
+	RelocationAMD64SecRel7  RelocationType = 0x000C // IMAGE_REL_AMD64_SECREL7, A 7-bit unsigned offset from the base of the section that contains the target
+	RelocationAMD64Token    RelocationType = 0x000D // IMAGE_REL_AMD64_TOKEN, CLR tokens
+	RelocationAMD64SRel32   RelocationType = 0x000E // IMAGE_REL_AMD64_SREL32, A 32-bit signed span-dependent value emitted into the object
+	RelocationAMD64Pair     RelocationType = 0x000F // IMAGE_REL_AMD64_PAIR, A pair that must immediately follow every span-dependent value
+	RelocationAMD64SSpan32  RelocationType = 0x0010 // IMAGE_REL_AMD64_SSPAN32, A 32-bit signed span-dependent value that is applied at link time
+)
+
+var relocationNamesAMD64 = map[RelocationType]string{
+	RelocationAMD64Absolute: "IMAGE_REL_AMD64_ABSOLUTE",
+	RelocationAMD64Addr64:   "IMAGE_REL_AMD64_ADDR64",
+	RelocationAMD64Addr32:   "IMAGE_REL_AMD64_ADDR32",
+	RelocationAMD64Addr32NB: "IMAGE_REL_AMD64_ADDR32NB",
+	RelocationAMD64Rel32:    "IMAGE_REL_AMD64_REL32",
+	RelocationAMD64Rel32_1:  "IMAGE_REL_AMD64_REL32_1",
+	RelocationAMD64Rel32_2:  "IMAGE_REL_AMD64_REL32_2",
+	RelocationAMD64Rel32_3:  "IMAGE_REL_AMD64_REL32_3",
+	RelocationAMD64Rel32_4:  "IMAGE_REL_AMD64_REL32_4",
+	RelocationAMD64Rel32_5:  "IMAGE_REL_AMD64_REL32_5",
+	RelocationAMD64Section:  "IMAGE_REL_AMD64_SECTION",
+	RelocationAMD64SecRel:   "IMAGE_REL_AMD64_SECREL",
+	RelocationAMD64SecRel7:  "IMAGE_REL_AMD64_SECREL7",
+	RelocationAMD64Token:    "IMAGE_REL_AMD64_TOKEN",
+	RelocationAMD64SRel32:   "IMAGE_REL_AMD64_SREL32",
+	RelocationAMD64Pair:     "IMAGE_REL_AMD64_PAIR",
+	RelocationAMD64SSpan32:  "IMAGE_REL_AMD64_SSPAN32",
+}
+
+// X86 relocation types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#type-indicators
+const (
+	RelocationX86Absolute RelocationType = 0x0000 // IMAGE_REL_I386_ABSOLUTE, The relocation is ignored
+	RelocationX86Dir16    RelocationType = 0x0001 // IMAGE_REL_I386_DIR16, Not supported
+	RelocationX86Rel16    RelocationType = 0x0002 // IMAGE_REL_I386_REL16, Not supported
+	RelocationX86Dir32    RelocationType = 0x0006 // IMAGE_REL_I386_DIR32, The target's 32-bit VA
+	RelocationX86Dir32NB  RelocationType = 0x0007 // IMAGE_REL_I386_DIR32NB, The target's 32-bit RVA
+	RelocationX86Seg12    RelocationType = 0x0009 // IMAGE_REL_I386_SEG12, Not supported
+	RelocationX86Section  RelocationType = 0x000A // IMAGE_REL_I386_SECTION, The 16-bit section index of the section that contains the target
+	RelocationX86SecRel   RelocationType = 0x000B // IMAGE_REL_I386_SECREL, The 32-bit offset of the target from the beginning of its section
+	RelocationX86Token    RelocationType = 0x000C // IMAGE_REL_I386_TOKEN, The CLR token
+	RelocationX86SecRel7  RelocationType = 0x000D // IMAGE_REL_I386_SECREL7, A 7-bit offset from the base of the section that contains the target
+	RelocationX86Rel32    RelocationType = 0x0014 // IMAGE_REL_I386_REL32, The 32-bit relative displacement to the target
+)
+
+var relocationNamesX86 = map[RelocationType]string{
+	RelocationX86Absolute: "IMAGE_REL_I386_ABSOLUTE",
+	RelocationX86Dir16:    "IMAGE_REL_I386_DIR16",
+	RelocationX86Rel16:    "IMAGE_REL_I386_REL16",
+	RelocationX86Dir32:    "IMAGE_REL_I386_DIR32",
+	RelocationX86Dir32NB:  "IMAGE_REL_I386_DIR32NB",
+	RelocationX86Seg12:    "IMAGE_REL_I386_SEG12",
+	RelocationX86Section:  "IMAGE_REL_I386_SECTION",
+	RelocationX86SecRel:   "IMAGE_REL_I386_SECREL",
+	RelocationX86Token:    "IMAGE_REL_I386_TOKEN",
+	RelocationX86SecRel7:  "IMAGE_REL_I386_SECREL7",
+	RelocationX86Rel32:    "IMAGE_REL_I386_REL32",
+}
+
+// ARM relocation types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#type-indicators
+const (
+	RelocationARMAbsolute      RelocationType = 0x0000 // IMAGE_REL_ARM_ABSOLUTE, The relocation is ignored
+	RelocationARMAddr32        RelocationType = 0x0001 // IMAGE_REL_ARM_ADDR32, The 32-bit VA of the target
+	RelocationARMAddr32NB      RelocationType = 0x0002 // IMAGE_REL_ARM_ADDR32NB, The 32-bit RVA of the target
+	RelocationARMBranch24      RelocationType = 0x0003 // IMAGE_REL_ARM_BRANCH24, The 24-bit relative displacement to the target
+	RelocationARMBranch11      RelocationType = 0x0004 // IMAGE_REL_ARM_BRANCH11, The reference to a subroutine call
+	RelocationARMToken         RelocationType = 0x0005 // IMAGE_REL_ARM_TOKEN, The CLR token
+	RelocationARMGPRel12       RelocationType = 0x0006 // IMAGE_REL_ARM_GPREL12, Not supported
+	RelocationARMGPRel7        RelocationType = 0x0007 // IMAGE_REL_ARM_GPREL7, Not supported
+	RelocationARMBLX24         RelocationType = 0x0008 // IMAGE_REL_ARM_BLX24, The 24-bit relative displacement to the target, switching to Thumb
+	RelocationARMBLX11         RelocationType = 0x0009 // IMAGE_REL_ARM_BLX11, The reference to a subroutine call, switching to Thumb
+	RelocationARMRel32         RelocationType = 0x000A // IMAGE_REL_ARM_REL32, The 32-bit relative address from the byte following the relocation
+	RelocationARMSection       RelocationType = 0x000E // IMAGE_REL_ARM_SECTION, The 16-bit section index of the section that contains the target
+	RelocationARMSecRel        RelocationType = 0x000F // IMAGE_REL_ARM_SECREL, The 32-bit offset of the target from the beginning of its section
+	RelocationARMMov32         RelocationType = 0x0010 // IMAGE_REL_ARM_MOV32, The 32-bit VA of the target, applied to a MOVW and MOVT pair
+	RelocationARMThumbMov32    RelocationType = 0x0011 // IMAGE_REL_ARM_THUMB_MOV32, The 32-bit VA of the target, applied to a Thumb MOVW and MOVT pair
+	RelocationARMThumbBranch20 RelocationType = 0x0012 // IMAGE_REL_ARM_THUMB_BRANCH20, The 21-bit relative displacement of a Thumb B instruction
+	RelocationARMThumbBranch24 RelocationType = 0x0014 // IMAGE_REL_ARM_THUMB_BRANCH24, The 25-bit relative displacement of a Thumb BL instruction
+	RelocationARMThumbBLX23    RelocationType = 0x0015 // IMAGE_REL_ARM_THUMB_BLX23, The 23-bit relative displacement of a Thumb BLX instruction
+	RelocationARMPair          RelocationType = 0x0016 // IMAGE_REL_ARM_PAIR, A pair that must immediately follow a REFHI relocation
+)
+
+var relocationNamesARM = map[RelocationType]string{
+	RelocationARMAbsolute:      "IMAGE_REL_ARM_ABSOLUTE",
+	RelocationARMAddr32:        "IMAGE_REL_ARM_ADDR32",
+	RelocationARMAddr32NB:      "IMAGE_REL_ARM_ADDR32NB",
+	RelocationARMBranch24:      "IMAGE_REL_ARM_BRANCH24",
+	RelocationARMBranch11:      "IMAGE_REL_ARM_BRANCH11",
+	RelocationARMToken:         "IMAGE_REL_ARM_TOKEN",
+	RelocationARMGPRel12:       "IMAGE_REL_ARM_GPREL12",
+	RelocationARMGPRel7:        "IMAGE_REL_ARM_GPREL7",
+	RelocationARMBLX24:         "IMAGE_REL_ARM_BLX24",
+	RelocationARMBLX11:         "IMAGE_REL_ARM_BLX11",
+	RelocationARMRel32:         "IMAGE_REL_ARM_REL32",
+	RelocationARMSection:       "IMAGE_REL_ARM_SECTION",
+	RelocationARMSecRel:        "IMAGE_REL_ARM_SECREL",
+	RelocationARMMov32:         "IMAGE_REL_ARM_MOV32",
+	RelocationARMThumbMov32:    "IMAGE_REL_ARM_THUMB_MOV32",
+	RelocationARMThumbBranch20: "IMAGE_REL_ARM_THUMB_BRANCH20",
+	RelocationARMThumbBranch24: "IMAGE_REL_ARM_THUMB_BRANCH24",
+	RelocationARMThumbBLX23:    "IMAGE_REL_ARM_THUMB_BLX23",
+	RelocationARMPair:          "IMAGE_REL_ARM_PAIR",
+}
+
+// ARM64 relocation types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#type-indicators
+const (
+	RelocationARM64Absolute      RelocationType = 0x0000 // IMAGE_REL_ARM64_ABSOLUTE, The relocation is ignored
+	RelocationARM64Addr32        RelocationType = 0x0001 // IMAGE_REL_ARM64_ADDR32, The 32-bit VA of the target
+	RelocationARM64Addr32NB      RelocationType = 0x0002 // IMAGE_REL_ARM64_ADDR32NB, The 32-bit RVA of the target
+	RelocationARM64Branch26      RelocationType = 0x0003 // IMAGE_REL_ARM64_BRANCH26, The 26-bit relative displacement to the target, for B and BL instructions
+	RelocationARM64PageBaseRel21 RelocationType = 0x0004 // IMAGE_REL_ARM64_PAGEBASE_REL21, The page base of the target, for ADRP instructions
+	RelocationARM64Rel21         RelocationType = 0x0005 // IMAGE_REL_ARM64_REL21, The 12-bit relative displacement to the target, for ADR instructions
+	RelocationARM64PageOffset12A RelocationType = 0x0006 // IMAGE_REL_ARM64_PAGEOFFSET_12A, The 12-bit page offset of the target, for ADD and ADDS instructions
+	RelocationARM64PageOffset12L RelocationType = 0x0007 // IMAGE_REL_ARM64_PAGEOFFSET_12L, The 12-bit page offset of the target, for LDR instructions
+	RelocationARM64SecRel        RelocationType = 0x0008 // IMAGE_REL_ARM64_SECREL, The 32-bit offset of the target from the beginning of its section
+	RelocationARM64SecRelLow12A  RelocationType = 0x0009 // IMAGE_REL_ARM64_SECREL_LOW12A, Bits 0 to 11 of the section offset of the target, for ADD and ADDS instructions
+	RelocationARM64SecRelHigh12A RelocationType = 0x000A // IMAGE_REL_ARM64_SECREL_HIGH12A, Bits 12 to 23 of the section offset of the target, for ADD and ADDS instructions
+	RelocationARM64SecRelLow12L  RelocationType = 0x000B // IMAGE_REL_ARM64_SECREL_LOW12L, Bits 0 to 11 of the section offset of the target, for LDR instructions
+	RelocationARM64Token         RelocationType = 0x000C // IMAGE_REL_ARM64_TOKEN, The CLR token
+	RelocationARM64Section       RelocationType = 0x000D // IMAGE_REL_ARM64_SECTION, The 16-bit section index of the section that contains the target
+	RelocationARM64Addr64        RelocationType = 0x000E // IMAGE_REL_ARM64_ADDR64, The 64-bit VA of the target
+	RelocationARM64Branch19      RelocationType = 0x000F // IMAGE_REL_ARM64_BRANCH19, The 19-bit offset to the target, for conditional B instructions
+	RelocationARM64Branch14      RelocationType = 0x0010 // IMAGE_REL_ARM64_BRANCH14, The 14-bit offset to the target, for TBZ and TBNZ instructions
+	RelocationARM64Rel32         RelocationType = 0x0011 // IMAGE_REL_ARM64_REL32, The 32-bit relative address from the byte following the relocation
+)
+
+var relocationNamesARM64 = map[RelocationType]string{
+	RelocationARM64Absolute:      "IMAGE_REL_ARM64_ABSOLUTE",
+	RelocationARM64Addr32:        "IMAGE_REL_ARM64_ADDR32",
+	RelocationARM64Addr32NB:      "IMAGE_REL_ARM64_ADDR32NB",
+	RelocationARM64Branch26:      "IMAGE_REL_ARM64_BRANCH26",
+	RelocationARM64PageBaseRel21: "IMAGE_REL_ARM64_PAGEBASE_REL21",
+	RelocationARM64Rel21:         "IMAGE_REL_ARM64_REL21",
+	RelocationARM64PageOffset12A: "IMAGE_REL_ARM64_PAGEOFFSET_12A",
+	RelocationARM64PageOffset12L: "IMAGE_REL_ARM64_PAGEOFFSET_12L",
+	RelocationARM64SecRel:        "IMAGE_REL_ARM64_SECREL",
+	RelocationARM64SecRelLow12A:  "IMAGE_REL_ARM64_SECREL_LOW12A",
+	RelocationARM64SecRelHigh12A: "IMAGE_REL_ARM64_SECREL_HIGH12A",
+	RelocationARM64SecRelLow12L:  "IMAGE_REL_ARM64_SECREL_LOW12L",
+	RelocationARM64Token:         "IMAGE_REL_ARM64_TOKEN",
+	RelocationARM64Section:       "IMAGE_REL_ARM64_SECTION",
+	RelocationARM64Addr64:        "IMAGE_REL_ARM64_ADDR64",
+	RelocationARM64Branch19:      "IMAGE_REL_ARM64_BRANCH19",
+	RelocationARM64Branch14:      "IMAGE_REL_ARM64_BRANCH14",
+	RelocationARM64Rel32:         "IMAGE_REL_ARM64_REL32",
+}
@@ -0,0 +1,36 @@
+package coff
+
+import (
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Section describes a section within an object file.
+type Section struct {
+	// Number is the 1-based number of the section, which is used by
+	// symbols to refer to it.
+	Number SectionNumber
+
+	// Name is the name of the section. Long names are resolved from the
+	// string table.
+	Name string
+
+	// Location is the file range of the section's data. It is zero for
+	// sections that hold uninitialized data.
+	Location imagefile.FileRange
+
+	// Relocations is the file offset of the section's relocation entries.
+	Relocations imagefile.FileOffset
+
+	// NumberOfRelocations is the number of relocation entries declared by
+	// the section header. For sections with extended relocations, the
+	// actual count is stored in the first relocation entry.
+	NumberOfRelocations uint16
+
+	// Characteristics holds the flags that describe the section.
+	Characteristics imagefile.SectionCharacteristics
+}
+
+// IsCOMDAT returns true if the section holds COMDAT data.
+func (section Section) IsCOMDAT() bool {
+	return section.Characteristics&imagefile.SectionLinkCOMDAT != 0
+}
@@ -0,0 +1,275 @@
+package coff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// SectionNumber identifies the section that a symbol is defined in. Section
+// numbers are 1-based. Zero and negative values have special meanings.
+type SectionNumber int32
+
+// Special section numbers.
+const (
+	SectionUndefined SectionNumber = 0  // IMAGE_SYM_UNDEFINED, The symbol is external or common
+	SectionAbsolute  SectionNumber = -1 // IMAGE_SYM_ABSOLUTE, The symbol has an absolute value
+	SectionDebug     SectionNumber = -2 // IMAGE_SYM_DEBUG, The symbol provides debugging information
+)
+
+// String returns a string representation of the section number.
+func (number SectionNumber) String() string {
+	switch number {
+	case SectionUndefined:
+		return "UNDEF"
+	case SectionAbsolute:
+		return "ABS"
+	case SectionDebug:
+		return "DEBUG"
+	default:
+		return strconv.Itoa(int(number))
+	}
+}
+
+// StorageClass identifies the kind of definition that a symbol represents.
+type StorageClass uint8
+
+// Storage classes.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#storage-class
+const (
+	ClassEndOfFunction   StorageClass = 0xFF // IMAGE_SYM_CLASS_END_OF_FUNCTION
+	ClassNull            StorageClass = 0    // IMAGE_SYM_CLASS_NULL
+	ClassAutomatic       StorageClass = 1    // IMAGE_SYM_CLASS_AUTOMATIC
+	ClassExternal        StorageClass = 2    // IMAGE_SYM_CLASS_EXTERNAL
+	ClassStatic          StorageClass = 3    // IMAGE_SYM_CLASS_STATIC
+	ClassRegister        StorageClass = 4    // IMAGE_SYM_CLASS_REGISTER
+	ClassExternalDef     StorageClass = 5    // IMAGE_SYM_CLASS_EXTERNAL_DEF
+	ClassLabel           StorageClass = 6    // IMAGE_SYM_CLASS_LABEL
+	ClassUndefinedLabel  StorageClass = 7    // IMAGE_SYM_CLASS_UNDEFINED_LABEL
+	ClassMemberOfStruct  StorageClass = 8    // IMAGE_SYM_CLASS_MEMBER_OF_STRUCT
+	ClassArgument        StorageClass = 9    // IMAGE_SYM_CLASS_ARGUMENT
+	ClassStructTag       StorageClass = 10   // IMAGE_SYM_CLASS_STRUCT_TAG
+	ClassMemberOfUnion   StorageClass = 11   // IMAGE_SYM_CLASS_MEMBER_OF_UNION
+	ClassUnionTag        StorageClass = 12   // IMAGE_SYM_CLASS_UNION_TAG
+	ClassTypeDefinition  StorageClass = 13   // IMAGE_SYM_CLASS_TYPE_DEFINITION
+	ClassUndefinedStatic StorageClass = 14   // IMAGE_SYM_CLASS_UNDEFINED_STATIC
+	ClassEnumTag         StorageClass = 15   // IMAGE_SYM_CLASS_ENUM_TAG
+	ClassMemberOfEnum    StorageClass = 16   // IMAGE_SYM_CLASS_MEMBER_OF_ENUM
+	ClassRegisterParam   StorageClass = 17   // IMAGE_SYM_CLASS_REGISTER_PARAM
+	ClassBitField        StorageClass = 18   // IMAGE_SYM_CLASS_BIT_FIELD
+	ClassBlock           StorageClass = 100  // IMAGE_SYM_CLASS_BLOCK
+	ClassFunction        StorageClass = 101  // IMAGE_SYM_CLASS_FUNCTION
+	ClassEndOfStruct     StorageClass = 102  // IMAGE_SYM_CLASS_END_OF_STRUCT
+	ClassFile            StorageClass = 103  // IMAGE_SYM_CLASS_FILE
+	ClassSection         StorageClass = 104  // IMAGE_SYM_CLASS_SECTION
+	ClassWeakExternal    StorageClass = 105  // IMAGE_SYM_CLASS_WEAK_EXTERNAL
+	ClassCLRToken        StorageClass = 107  // IMAGE_SYM_CLASS_CLR_TOKEN
+)
+
+// String returns a string representation of the storage class.
+func (class StorageClass) String() string {
+	switch class {
+	case ClassEndOfFunction:
+		return "EndOfFunction"
+	case ClassNull:
+		return "Null"
+	case ClassAutomatic:
+		return "Automatic"
+	case ClassExternal:
+		return "External"
+	case ClassStatic:
+		return "Static"
+	case ClassRegister:
+		return "Register"
+	case ClassExternalDef:
+		return "ExternalDef"
+	case ClassLabel:
+		return "Label"
+	case ClassUndefinedLabel:
+		return "UndefinedLabel"
+	case ClassMemberOfStruct:
+		return "MemberOfStruct"
+	case ClassArgument:
+		return "Argument"
+	case ClassStructTag:
+		return "StructTag"
+	case ClassMemberOfUnion:
+		return "MemberOfUnion"
+	case ClassUnionTag:
+		return "UnionTag"
+	case ClassTypeDefinition:
+		return "TypeDefinition"
+	case ClassUndefinedStatic:
+		return "UndefinedStatic"
+	case ClassEnumTag:
+		return "EnumTag"
+	case ClassMemberOfEnum:
+		return "MemberOfEnum"
+	case ClassRegisterParam:
+		return "RegisterParam"
+	case ClassBitField:
+		return "BitField"
+	case ClassBlock:
+		return "Block"
+	case ClassFunction:
+		return "Function"
+	case ClassEndOfStruct:
+		return "EndOfStruct"
+	case ClassFile:
+		return "File"
+	case ClassSection:
+		return "Section"
+	case ClassWeakExternal:
+		return "WeakExternal"
+	case ClassCLRToken:
+		return "CLRToken"
+	default:
+		return fmt.Sprintf("<unrecognized storage class: %d>", uint8(class))
+	}
+}
+
+// typeFunction is the complex type of a symbol that is a function
+// (IMAGE_SYM_DTYPE_FUNCTION), stored in the high byte of its type.
+const typeFunction = 0x20
+
+// Symbol is a record in the symbol table of an object file.
+type Symbol struct {
+	// Index is the index of the symbol within the symbol table. Auxiliary
+	// records occupy indices, so symbol indices are not contiguous.
+	Index uint32
+
+	// Name is the name of the symbol.
+	Name string
+
+	// Value is the value of the symbol. For symbols defined in a section,
+	// it is the offset of the symbol within the section.
+	Value uint32
+
+	// SectionNumber is the 1-based number of the section that the symbol
+	// is defined in, or one of the special section numbers.
+	SectionNumber SectionNumber
+
+	// Type is the type of the symbol.
+	Type uint16
+
+	// StorageClass is the storage class of the symbol.
+	StorageClass StorageClass
+
+	// Aux holds the auxiliary records that follow the symbol, if any.
+	Aux [][]byte
+}
+
+// IsFunction returns true if the symbol's type indicates that it is a
+// function.
+func (sym Symbol) IsFunction() bool {
+	return sym.Type&0xF0 == typeFunction
+}
+
+// IsExternal returns true if the symbol is visible to other object files.
+func (sym Symbol) IsExternal() bool {
+	return sym.StorageClass == ClassExternal || sym.StorageClass == ClassWeakExternal
+}
+
+// IsUndefined returns true if the symbol is an external reference to a
+// symbol defined elsewhere.
+func (sym Symbol) IsUndefined() bool {
+	return sym.StorageClass == ClassExternal && sym.SectionNumber == SectionUndefined && sym.Value == 0
+}
+
+// String returns a string representation of the symbol.
+func (sym Symbol) String() string {
+	return fmt.Sprintf("[%d] %s (section %s, value 0x%x, %s)", sym.Index, sym.Name, sym.SectionNumber, sym.Value, sym.StorageClass)
+}
+
+// SectionDefinition is the auxiliary record of a symbol that defines a
+// section. It is used to describe COMDAT sections.
+type SectionDefinition struct {
+	Length              uint32
+	NumberOfRelocations uint16
+	NumberOfLinenumbers uint16
+	CheckSum            uint32
+
+	// Number is the 1-based number of the section that an associative
+	// COMDAT section is associated with.
+	Number SectionNumber
+
+	// Selection is the COMDAT selection rule of the section.
+	Selection ComdatSelection
+}
+
+// SectionDefinition returns the section definition held by the symbol's
+// auxiliary record. It returns false if the symbol does not define a
+// section.
+func (sym Symbol) SectionDefinition() (SectionDefinition, bool) {
+	if sym.StorageClass != ClassStatic || sym.Value != 0 || sym.IsFunction() || sym.SectionNumber <= 0 || len(sym.Aux) == 0 {
+		return SectionDefinition{}, false
+	}
+	aux := sym.Aux[0]
+	number := uint32(binary.LittleEndian.Uint16(aux[12:14]))
+	if len(aux) >= 20 {
+		// Extended object files have larger records, which store the high
+		// bits of the associated section number in otherwise unused space.
+		number |= uint32(binary.LittleEndian.Uint16(aux[16:18])) << 16
+	}
+	return SectionDefinition{
+		Length:              binary.LittleEndian.Uint32(aux[0:4]),
+		NumberOfRelocations: binary.LittleEndian.Uint16(aux[4:6]),
+		NumberOfLinenumbers: binary.LittleEndian.Uint16(aux[6:8]),
+		CheckSum:            binary.LittleEndian.Uint32(aux[8:12]),
+		Number:              SectionNumber(number),
+		Selection:           ComdatSelection(aux[14]),
+	}, true
+}
+
+// FileName returns the name of the source file held by the auxiliary
+// records of a file symbol. It returns false if the symbol is not a file
+// symbol.
+func (sym Symbol) FileName() (string, bool) {
+	if sym.StorageClass != ClassFile {
+		return "", false
+	}
+	name := bytes.Join(sym.Aux, nil)
+	if cutoff := bytes.IndexByte(name, 0); cutoff >= 0 {
+		name = name[:cutoff]
+	}
+	return string(name), true
+}
+
+// WeakExternal is the auxiliary record of a weak external symbol.
+type WeakExternal struct {
+	// TagIndex is the symbol table index of the symbol that is used if the
+	// weak external is not resolved.
+	TagIndex uint32
+
+	// Characteristics determines how the symbol is resolved.
+	Characteristics uint32
+}
+
+// WeakExternal returns the weak external definition held by the symbol's
+// auxiliary record. It returns false if the symbol is not a weak external.
+func (sym Symbol) WeakExternal() (WeakExternal, bool) {
+	if sym.StorageClass != ClassWeakExternal || len(sym.Aux) == 0 {
+		return WeakExternal{}, false
+	}
+	aux := sym.Aux[0]
+	return WeakExternal{
+		TagIndex:        binary.LittleEndian.Uint32(aux[0:4]),
+		Characteristics: binary.LittleEndian.Uint32(aux[4:8]),
+	}, true
+}
+
+// SymbolTable holds the symbols of an object file in the order that they
+// appear.
+type SymbolTable []Symbol
+
+// Lookup returns the symbol with the given symbol table index.
+func (table SymbolTable) Lookup(index uint32) (Symbol, bool) {
+	i := sort.Search(len(table), func(i int) bool { return table[i].Index >= index })
+	if i < len(table) && table[i].Index == index {
+		return table[i], true
+	}
+	return Symbol{}, false
+}
@@ -19,16 +19,150 @@ func (s Signature) Valid() bool {
 	return s == 0x5A4D
 }
 
+// HeaderSize is the size of the DOS compatibility header used by image
+// files that have a new executable header, such as a PE header.
+const HeaderSize = 64
+
+// ParagraphSize is the size of a DOS paragraph, which is the unit used to
+// express the size of the header and memory allocations.
+const ParagraphSize = 16
+
+// PageSize is the size of a DOS page, which is the unit used to express the
+// size of an executable.
+const PageSize = 512
+
 // Header is a 64 byte compatibility header at the start of PE image files
 // which allows them to be handled gracefully by the DOS operating system.
-type Header [64]byte
+// It is the IMAGE_DOS_HEADER structure.
+type Header [HeaderSize]byte
 
 // Signature returns the two byte signature at the start of the header.
 func (header *Header) Signature() Signature {
 	return Signature(binary.LittleEndian.Uint16(header[:2]))
 }
 
-// NextHeader returns the address of the next header within an image file.
+// BytesOnLastPage returns the number of bytes used in the last page of the
+// executable. A value of zero means the whole page is used (e_cblp).
+func (header *Header) BytesOnLastPage() uint16 {
+	return binary.LittleEndian.Uint16(header[2:4])
+}
+
+// Pages returns the number of pages in the executable, including the last
+// page, which may be partially used (e_cp).
+func (header *Header) Pages() uint16 {
+	return binary.LittleEndian.Uint16(header[4:6])
+}
+
+// Relocations returns the number of entries in the relocation table
+// (e_crlc).
+func (header *Header) Relocations() uint16 {
+	return binary.LittleEndian.Uint16(header[6:8])
+}
+
+// HeaderParagraphs returns the size of the header in paragraphs, including
+// the relocation table (e_cparhdr).
+func (header *Header) HeaderParagraphs() uint16 {
+	return binary.LittleEndian.Uint16(header[8:10])
+}
+
+// MinAlloc returns the minimum number of extra paragraphs of memory needed
+// by the program (e_minalloc).
+func (header *Header) MinAlloc() uint16 {
+	return binary.LittleEndian.Uint16(header[10:12])
+}
+
+// MaxAlloc returns the maximum number of extra paragraphs of memory wanted
+// by the program (e_maxalloc).
+func (header *Header) MaxAlloc() uint16 {
+	return binary.LittleEndian.Uint16(header[12:14])
+}
+
+// InitialSS returns the initial value of the SS register, relative to the
+// start of the load module (e_ss).
+func (header *Header) InitialSS() uint16 {
+	return binary.LittleEndian.Uint16(header[14:16])
+}
+
+// InitialSP returns the initial value of the SP register (e_sp).
+func (header *Header) InitialSP() uint16 {
+	return binary.LittleEndian.Uint16(header[16:18])
+}
+
+// CheckSum returns the checksum of the executable, which is rarely used
+// (e_csum).
+func (header *Header) CheckSum() uint16 {
+	return binary.LittleEndian.Uint16(header[18:20])
+}
+
+// InitialIP returns the initial value of the IP register (e_ip).
+func (header *Header) InitialIP() uint16 {
+	return binary.LittleEndian.Uint16(header[20:22])
+}
+
+// InitialCS returns the initial value of the CS register, relative to the
+// start of the load module (e_cs).
+func (header *Header) InitialCS() uint16 {
+	return binary.LittleEndian.Uint16(header[22:24])
+}
+
+// RelocationTable returns the file offset of the relocation table
+// (e_lfarlc).
+func (header *Header) RelocationTable() imagefile.FileOffset {
+	return imagefile.FileOffset(binary.LittleEndian.Uint16(header[24:26]))
+}
+
+// OverlayNumber returns the overlay number, which is zero for the main
+// program (e_ovno).
+func (header *Header) OverlayNumber() uint16 {
+	return binary.LittleEndian.Uint16(header[26:28])
+}
+
+// OEMID returns the OEM identifier for the OEM information (e_oemid).
+func (header *Header) OEMID() uint16 {
+	return binary.LittleEndian.Uint16(header[36:38])
+}
+
+// OEMInfo returns OEM information that is specific to the OEM identifier
+// (e_oeminfo).
+func (header *Header) OEMInfo() uint16 {
+	return binary.LittleEndian.Uint16(header[38:40])
+}
+
+// NextHeader returns the address of the next header within an image file
+// (e_lfanew).
+//
+// The field is only meaningful for executables that have a new executable
+// header. See [Header.HasNextHeader].
 func (header *Header) NextHeader() imagefile.FileOffset {
 	return imagefile.FileOffset(binary.LittleEndian.Uint32(header[60:]))
 }
+
+// HasNextHeader returns true if the header appears to be followed by a new
+// executable header, such as a PE, NE or LE header.
+//
+// Plain DOS executables may use the space occupied by the e_lfanew field
+// for other purposes. By convention, executables with a new executable
+// header place the relocation table at or after the end of the 64 byte
+// header, and set the e_lfanew field to a location after it.
+func (header *Header) HasNextHeader() bool {
+	if header.RelocationTable() < HeaderSize {
+		return false
+	}
+	return header.NextHeader() >= HeaderSize
+}
+
+// LoadModule returns the file range of the load module, which is the
+// program image that DOS loads into memory. It follows the header
+// paragraphs and extends to the end of the executable as declared by the
+// header.
+func (header *Header) LoadModule() imagefile.FileRange {
+	size := uint(header.Pages()) * PageSize
+	if last := uint(header.BytesOnLastPage()); last != 0 && size >= PageSize {
+		size -= PageSize - last
+	}
+	start := uint(header.HeaderParagraphs()) * ParagraphSize
+	if start >= size {
+		return imagefile.FileRange{Start: imagefile.FileOffset(start)}
+	}
+	return imagefile.FileRange{Start: imagefile.FileOffset(start), Length: size - start}
+}
@@ -0,0 +1,81 @@
+package dos
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Kind identifies the kind of executable that a file holds, as determined
+// by the signature of the header that follows the DOS header.
+type Kind int
+
+// Kinds of executables.
+const (
+	KindDOS     Kind = iota // A plain DOS executable without a new executable header
+	KindNE                  // A 16-bit Windows or OS/2 new executable
+	KindLE                  // A linear executable, used by VxDs and DOS extenders
+	KindLX                  // A linear executable, used by 32-bit OS/2
+	KindPE                  // A portable executable
+	KindUnknown             // A new executable header with an unrecognized signature
+)
+
+// String returns a string representation of the executable kind.
+func (kind Kind) String() string {
+	switch kind {
+	case KindDOS:
+		return "DOS"
+	case KindNE:
+		return "NE"
+	case KindLE:
+		return "LE"
+	case KindLX:
+		return "LX"
+	case KindPE:
+		return "PE"
+	case KindUnknown:
+		return "Unknown"
+	default:
+		return fmt.Sprintf("<unrecognized executable kind: %d>", int(kind))
+	}
+}
+
+// Kind examines the signature of the new executable header and returns the
+// kind of executable held by the file.
+//
+// If the DOS header does not refer to a new executable header, or if the
+// header lies beyond the end of the file, it returns [KindDOS].
+//
+// The Windows loader ignores the relocation table offset that
+// [Header.HasNextHeader] relies on, and some packed or hand-built images
+// set it to zero. When the header doesn't appear to refer to a new
+// executable header, the e_lfanew field is still examined, but only a
+// recognized signature is reported.
+func (r *Reader) Kind() (Kind, error) {
+	likely := r.header.HasNextHeader()
+
+	var signature [4]byte
+	next := r.header.NextHeader()
+	n, err := r.source.ReadAt(signature[:], int64(next))
+	if err != nil && !errors.Is(err, io.EOF) {
+		if !likely {
+			return KindDOS, nil
+		}
+		return KindUnknown, fmt.Errorf("failed to read the new executable header signature at offset %s: %w", next, err)
+	}
+
+	switch {
+	case n == 4 && string(signature[:]) == "PE\x00\x00":
+		return KindPE, nil
+	case n >= 2 && string(signature[:2]) == "NE":
+		return KindNE, nil
+	case n >= 2 && string(signature[:2]) == "LE":
+		return KindLE, nil
+	case n >= 2 && string(signature[:2]) == "LX":
+		return KindLX, nil
+	case n < 2 || !likely:
+		return KindDOS, nil
+	default:
+		return KindUnknown, nil
+	}
+}
@@ -0,0 +1,139 @@
+package dos
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// minHeaderSize is the size of the formatted portion of the DOS header,
+// which is all that plain DOS executables are required to have.
+const minHeaderSize = 28
+
+// maxRichHeaderSize is the largest rich header that is looked for at the
+// end of a DOS stub.
+const maxRichHeaderSize = 64 * 1024
+
+// Reader reads DOS executable data from an underlying [io.ReaderAt].
+//
+// It can read plain DOS executables as well as the DOS portion of image
+// files that have a new executable header, such as PE image files.
+type Reader struct {
+	source io.ReaderAt
+	header Header
+}
+
+// NewReader creates and initializes a new DOS executable [Reader] that reads
+// from source.
+//
+// It reads and validates the DOS header. If the validation fails it returns
+// an error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	r := &Reader{source: source}
+	n, err := source.ReadAt(r.header[:], 0)
+	if err != nil && !(errors.Is(err, io.EOF) && n >= minHeaderSize) {
+		return nil, fmt.Errorf("failed the read the DOS file header: %w", err)
+	}
+	if signature := r.header.Signature(); !signature.Valid() {
+		return nil, fmt.Errorf("the file does not have the expected DOS file header signature")
+	}
+	return r, nil
+}
+
+// Header returns the DOS header. If the file is too small to hold a
+// complete header, the missing bytes are zero.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Relocation is an entry in the DOS relocation table. It identifies a
+// segment address within the load module that must be adjusted by the
+// segment that the program is loaded at.
+type Relocation struct {
+	Offset  uint16
+	Segment uint16
+}
+
+// Address returns the offset of the relocated word from the start of the
+// load module.
+func (relocation Relocation) Address() uint32 {
+	return uint32(relocation.Segment)*ParagraphSize + uint32(relocation.Offset)
+}
+
+// String returns a string representation of the relocation in
+// segment:offset form.
+func (relocation Relocation) String() string {
+	return fmt.Sprintf("%04X:%04X", relocation.Segment, relocation.Offset)
+}
+
+// ReadRelocations reads the entries of the DOS relocation table.
+func (r *Reader) ReadRelocations() ([]Relocation, error) {
+	count := int(r.header.Relocations())
+	if count == 0 {
+		return nil, nil
+	}
+	data := make([]byte, count*4)
+	start := r.header.RelocationTable()
+	if _, err := r.source.ReadAt(data, int64(start)); err != nil {
+		return nil, fmt.Errorf("failed to read the DOS relocation table at offset %s: %w", start, err)
+	}
+	relocations := make([]Relocation, count)
+	for i := range relocations {
+		relocations[i] = Relocation{
+			Offset:  uint16(data[i*4]) | uint16(data[i*4+1])<<8,
+			Segment: uint16(data[i*4+2]) | uint16(data[i*4+3])<<8,
+		}
+	}
+	return relocations, nil
+}
+
+// ReadStub reads the DOS program.
+//
+// For plain DOS executables this is the entire load module. For image
+// files with a new executable header, it is the stub program that runs
+// when the image is executed under DOS. It ends where the rich header or
+// the new executable header begins, whichever comes first.
+func (r *Reader) ReadStub() (Stub, error) {
+	location := r.header.LoadModule()
+	end := location.Start + imagefile.FileOffset(location.Length)
+
+	if r.header.HasNextHeader() {
+		next := r.header.NextHeader()
+		if next < end {
+			end = next
+		}
+
+		// Exclude the rich header, which the linker places between the
+		// stub and the new executable header. Only a rich header that
+		// starts within the stub matters, so the window that is scanned
+		// is bounded even if the new executable header offset is huge.
+		window := next
+		if limit := end + maxRichHeaderSize; window > limit {
+			window = limit
+		}
+		prefix := make([]byte, window)
+		if _, err := r.source.ReadAt(prefix, 0); err == nil {
+			if rich, err := ParseRichHeader(prefix); err == nil && rich.Location.Start < end {
+				end = rich.Location.Start
+			}
+		}
+	}
+
+	if end < location.Start {
+		end = location.Start
+	}
+	location.Length = uint(end - location.Start)
+
+	data := make([]byte, location.Length)
+	n, err := r.source.ReadAt(data, int64(location.Start))
+	if err != nil && !(errors.Is(err, io.EOF) && !r.header.HasNextHeader()) {
+		return Stub{}, fmt.Errorf("failed to read the DOS stub at location %s: %w", location, err)
+	}
+
+	// Plain DOS executables sometimes declare a size that exceeds the size
+	// of the file.
+	location.Length = uint(n)
+	return Stub{Location: location, Data: data[:n]}, nil
+}
@@ -0,0 +1,168 @@
+package dos
+
+import (
+	"crypto"
+	_ "crypto/md5"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// ErrMissingRichHeader is returned by [ParseRichHeader] if the data does not
+// contain a rich header.
+var ErrMissingRichHeader = errors.New("the image file does not have a rich header")
+
+const (
+	richSignature = 0x68636952 // "Rich"
+	dansSignature = 0x536E6144 // "DanS"
+)
+
+// RichHeader is an undocumented structure that the Microsoft linker places
+// between the DOS stub and the PE header. It records the tools that
+// produced the objects that were linked into the image.
+//
+// The header is masked with a key that is also a checksum of the DOS
+// header, the DOS stub and the header's entries.
+type RichHeader struct {
+	// Location is the file range covered by the rich header, from the
+	// start of its "DanS" marker to the end of its key.
+	Location imagefile.FileRange
+
+	// Key is the value that the header was masked with. It is also the
+	// checksum that was recorded by the linker.
+	Key uint32
+
+	// CheckSum is the checksum computed from the image file. It should
+	// match the key.
+	CheckSum uint32
+
+	// Entries holds the decoded entries of the header.
+	Entries []RichEntry
+
+	// decoded holds the unmasked header data, from the start of its "DanS"
+	// marker up to its "Rich" marker.
+	decoded []byte
+}
+
+// Valid returns true if the computed checksum matches the key.
+func (rich RichHeader) Valid() bool {
+	return rich.CheckSum == rich.Key
+}
+
+// Hash returns a hash of the unmasked rich header data, from the start of
+// its "DanS" marker up to its "Rich" marker. This is the rich header hash
+// used by threat intelligence feeds, which typically use MD5.
+func (rich RichHeader) Hash(algorithm crypto.Hash) ([]byte, error) {
+	if !algorithm.Available() {
+		return nil, fmt.Errorf("the %s hash function is not available", algorithm)
+	}
+	h := algorithm.New()
+	h.Write(rich.decoded)
+	return h.Sum(nil), nil
+}
+
+// RichEntry is an entry in a rich header. It records the number of objects
+// that were produced by a particular tool and build.
+type RichEntry struct {
+	ProductID uint16
+	Build     uint16
+	Count     uint32
+}
+
+// CompID returns the combined product ID and build number of the entry, as
+// it is stored in the header.
+func (entry RichEntry) CompID() uint32 {
+	return uint32(entry.ProductID)<<16 | uint32(entry.Build)
+}
+
+// String returns a string representation of the entry.
+func (entry RichEntry) String() string {
+	return fmt.Sprintf("product %d build %d count %d", entry.ProductID, entry.Build, entry.Count)
+}
+
+// ParseRichHeader looks for a rich header in the given data and parses it.
+// The data must start at the beginning of the image file and should extend
+// up to the PE header.
+//
+// If the data does not contain a rich header it returns
+// [ErrMissingRichHeader].
+func ParseRichHeader(data []byte) (RichHeader, error) {
+	// Look for the "Rich" marker, which is followed by the key. It is
+	// always aligned to a 4 byte boundary.
+	end := -1
+	for offset := (len(data) - 8) &^ 3; offset >= len(Header{}); offset -= 4 {
+		if binary.LittleEndian.Uint32(data[offset:]) == richSignature {
+			end = offset
+			break
+		}
+	}
+	if end < 0 {
+		return RichHeader{}, ErrMissingRichHeader
+	}
+	key := binary.LittleEndian.Uint32(data[end+4:])
+
+	// Search backward for the masked "DanS" marker.
+	start := -1
+	for offset := end - 4; offset >= len(Header{}); offset -= 4 {
+		if binary.LittleEndian.Uint32(data[offset:])^key == dansSignature {
+			start = offset
+			break
+		}
+	}
+	if start < 0 {
+		return RichHeader{}, fmt.Errorf("the rich header at offset %s is missing its start marker", imagefile.FileOffset(end))
+	}
+
+	// Unmask the header.
+	decoded := make([]byte, end-start)
+	for i := 0; i < len(decoded); i += 4 {
+		binary.LittleEndian.PutUint32(decoded[i:], binary.LittleEndian.Uint32(data[start+i:])^key)
+	}
+
+	// The marker is followed by three words of padding, then by pairs of
+	// words that make up the entries.
+	const entriesStart = 16
+	if len(decoded) < entriesStart || (len(decoded)-entriesStart)%8 != 0 {
+		return RichHeader{}, fmt.Errorf("the rich header at offset %s has an invalid length of %d bytes", imagefile.FileOffset(start), len(decoded))
+	}
+	var entries []RichEntry
+	for i := entriesStart; i < len(decoded); i += 8 {
+		compID := binary.LittleEndian.Uint32(decoded[i:])
+		entries = append(entries, RichEntry{
+			ProductID: uint16(compID >> 16),
+			Build:     uint16(compID),
+			Count:     binary.LittleEndian.Uint32(decoded[i+4:]),
+		})
+	}
+
+	return RichHeader{
+		Location: imagefile.FileRange{Start: imagefile.FileOffset(start), Length: uint(end + 8 - start)},
+		Key:      key,
+		CheckSum: richCheckSum(data[:start], entries),
+		Entries:  entries,
+		decoded:  decoded,
+	}, nil
+}
+
+// richCheckSum computes the rich header checksum for the given data that
+// precedes the header and the given entries.
+func richCheckSum(prefix []byte, entries []RichEntry) uint32 {
+	checksum := uint32(len(prefix))
+	for i, b := range prefix {
+		// Skip the offset of the PE header, which isn't known when the
+		// checksum is computed.
+		if i >= 60 && i < 64 {
+			continue
+		}
+		checksum += bits.RotateLeft32(uint32(b), i)
+	}
+	for _, entry := range entries {
+		checksum += bits.RotateLeft32(entry.CompID(), int(entry.Count))
+	}
+	return checksum
+}
@@ -0,0 +1,89 @@
+package dos
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// StubKind identifies the kind of program held by a DOS stub.
+type StubKind int
+
+// Kinds of DOS stubs.
+const (
+	StubNone     StubKind = iota // The image does not have a stub program
+	StubStandard                 // The stub written by the Microsoft linker and compatible linkers
+	StubBorland                  // The stub written by the Borland and Embarcadero linkers
+	StubCustom                   // A stub that is not recognized
+)
+
+// String returns a string representation of the stub kind.
+func (kind StubKind) String() string {
+	switch kind {
+	case StubNone:
+		return "None"
+	case StubStandard:
+		return "Standard"
+	case StubBorland:
+		return "Borland"
+	case StubCustom:
+		return "Custom"
+	default:
+		return fmt.Sprintf("<unrecognized stub kind: %d>", int(kind))
+	}
+}
+
+// standardStubCode is the code of the stub program written by the
+// Microsoft linker. It prints the message that follows it and exits.
+var standardStubCode = []byte{
+	0x0E,             // push cs
+	0x1F,             // pop ds
+	0xBA, 0x0E, 0x00, // mov dx, 0x000E
+	0xB4, 0x09, // mov ah, 0x09
+	0xCD, 0x21, // int 0x21
+	0xB8, 0x01, 0x4C, // mov ax, 0x4C01
+	0xCD, 0x21, // int 0x21
+}
+
+// standardStubMessages are the messages printed by the standard stub.
+var standardStubMessages = [][]byte{
+	[]byte("This program cannot be run in DOS mode.\r\r\n$"),
+	[]byte("This program cannot be run in DOS mode.\r\n$"),
+}
+
+// borlandStubMessages are the messages printed by the Borland stub.
+var borlandStubMessages = [][]byte{
+	[]byte("This program must be run under Win32\r\n$"),
+	[]byte("This program must be run under Win64\r\n$"),
+}
+
+// Stub is the DOS program of an executable.
+type Stub struct {
+	Location imagefile.FileRange
+	Data     []byte
+}
+
+// Kind returns the kind of program held by the stub. Stubs with unexpected
+// code or messages are reported as [StubCustom], which may indicate that
+// the image was produced by an unusual toolchain or was tampered with.
+func (stub Stub) Kind() StubKind {
+	code := bytes.TrimRight(stub.Data, "\x00")
+	if len(code) == 0 {
+		return StubNone
+	}
+	if rest, ok := bytes.CutPrefix(code, standardStubCode); ok {
+		for _, message := range standardStubMessages {
+			if bytes.Equal(rest, message) {
+				return StubStandard
+			}
+		}
+		return StubCustom
+	}
+	for _, message := range borlandStubMessages {
+		if bytes.Contains(code, message) {
+			return StubBorland
+		}
+	}
+	return StubCustom
+}
@@ -0,0 +1,56 @@
+package imagefile
+
+// CheckSumWriter computes the checksum of an image file as its data is
+// written to it. It implements the algorithm used by the CheckSumMappedFile
+// function of the Windows image help library.
+//
+// The image file's data must be written to it in order, starting at offset
+// zero. The checksum field itself is treated as though it were zero.
+type CheckSumWriter struct {
+	field   FileRange
+	written uint64
+	sum     uint64
+}
+
+// NewCheckSumWriter returns a [CheckSumWriter] that excludes the checksum
+// field located at the given file range, which is typically obtained from
+// [Layout.CheckSum].
+func NewCheckSumWriter(field FileRange) *CheckSumWriter {
+	return &CheckSumWriter{field: field}
+}
+
+// Write adds the given data to the checksum. It always succeeds.
+func (w *CheckSumWriter) Write(p []byte) (n int, err error) {
+	for _, b := range p {
+		offset := FileOffset(w.written)
+		w.written++
+		if w.field.Contains(offset) {
+			continue
+		}
+
+		// The checksum is a sum of little-endian 16-bit words, so bytes at
+		// odd offsets contribute to the upper half of each word.
+		if offset%2 == 0 {
+			w.sum += uint64(b)
+		} else {
+			w.sum += uint64(b) << 8
+		}
+	}
+	return len(p), nil
+}
+
+// Size returns the number of bytes that have been written.
+func (w *CheckSumWriter) Size() uint64 {
+	return w.written
+}
+
+// Sum returns the checksum of the data written so far.
+func (w *CheckSumWriter) Sum() uint32 {
+	// Fold the carries back into the lower 16 bits, which produces the
+	// same result as folding after each word is added.
+	sum := w.sum
+	for sum>>16 != 0 {
+		sum = (sum & 0xFFFF) + (sum >> 16)
+	}
+	return uint32(sum) + uint32(w.written)
+}
@@ -18,6 +18,13 @@ func (header FileHeader) NumberOfSections() uint16 {
 	return binary.LittleEndian.Uint16(header[2:4])
 }
 
+// TimeDateStamp returns the time at which the file was created, as the
+// number of seconds since the Unix epoch. Many toolchains write a hash or
+// zero instead to produce reproducible output.
+func (header FileHeader) TimeDateStamp() uint32 {
+	return binary.LittleEndian.Uint32(header[4:8])
+}
+
 // PointerToSymbolTable returns the number of symbols present in the image file.
 func (header FileHeader) PointerToSymbolTable() FileOffset {
 	return FileOffset(binary.LittleEndian.Uint32(header[8:12]))
@@ -32,3 +39,8 @@ func (header FileHeader) NumberOfSymbols() uint32 {
 func (header FileHeader) SizeOfOptionalHeader() uint16 {
 	return binary.LittleEndian.Uint16(header[16:18])
 }
+
+// Characteristics returns the flags that describe the file.
+func (header FileHeader) Characteristics() uint16 {
+	return binary.LittleEndian.Uint16(header[18:20])
+}
@@ -7,8 +7,13 @@ const (
 	DataDirectorySize = 8
 	SectionHeaderSize = 40
 	SymbolSize        = 18
+	CheckSumSize      = 4
 )
 
+// CheckSumOffset is the offset of the image checksum within the COFF
+// optional header.
+const CheckSumOffset = 64
+
 // Layout keeps track of data used to determine the layout of an image file.
 type Layout struct {
 	Start                FileOffset
@@ -40,6 +45,36 @@ func (layout Layout) OptionalHeader() FileRange {
 	return FileRange{Start: layout.Start + SignatureSize + FileHeaderSize, Length: layout.SizeOfOptionalHeader}
 }
 
+// CheckSum returns the address range of the image checksum within the COFF
+// optional header. The checksum has the same location in both the PE32 and
+// PE32+ formats.
+func (layout Layout) CheckSum() FileRange {
+	return FileRange{Start: layout.Start + SignatureSize + FileHeaderSize + CheckSumOffset, Length: CheckSumSize}
+}
+
+// DataDirectoryEntry returns the address range of the entry for the data
+// directory with the given ID within a COFF optional header of the given
+// format.
+//
+// It returns a zero range if the format is not supported or the entry lies
+// beyond the end of the optional header.
+func (layout Layout) DataDirectoryEntry(format Format, id DirectoryID) FileRange {
+	var start uint
+	switch format {
+	case PE32:
+		start = MinOptionalHeaderSize32
+	case PE32Plus:
+		start = MinOptionalHeaderSize64
+	default:
+		return FileRange{}
+	}
+	start += uint(id) * DataDirectorySize
+	if start+DataDirectorySize > layout.SizeOfOptionalHeader {
+		return FileRange{}
+	}
+	return FileRange{Start: layout.Start + SignatureSize + FileHeaderSize + FileOffset(start), Length: DataDirectorySize}
+}
+
 // SymbolTable returns the address range of the COFF symbol table.
 func (layout Layout) SymbolTable() FileRange {
 	return FileRange{Start: layout.StartOfSymbolTable, Length: layout.NumberOfSymbols * SymbolSize}
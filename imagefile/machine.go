@@ -56,6 +56,24 @@ func (machine Machine) Supported() bool {
 	}
 }
 
+// Known returns true if the machine type is one of the well-known machine
+// types defined by the PE/COFF specification.
+func (machine Machine) Known() bool {
+	switch machine {
+	case MachineUnknown, MachineAlpha, MachineAlpha64, MachineAM33,
+		MachineAMD64, MachineARM, MachineARM64, MachineARM64EC, MachineARM64X,
+		MachineARMNT, MachineEBC, MachineX86, MachineIA64, MachineLoongArch32,
+		MachineLoongArch64, MachineM32R, MachineMIPS16, MachineMIPSFPU,
+		MachineMIPSFPU16, MachinePowerPC, MachinePowerPCFP, MachineR3000BE,
+		MachineR3000, MachineR4000, MachineR10000, MachineRISCV32, MachineRISCV64,
+		MachineRISCV128, MachineSH3, MachineSH3DSP, MachineSH4, MachineSH5,
+		MachineThumb, MachineWCEMIPSV2:
+		return true
+	default:
+		return false
+	}
+}
+
 // String returns a string representation of the machine.
 func (machine Machine) String() string {
 	switch machine {
@@ -5,6 +5,10 @@ import "encoding/binary"
 // OptionalHeader is a common interface implemented by both the PE32 and P32+
 // optional header formats.
 type OptionalHeader interface {
+	MajorLinkerVersion() uint8
+	MinorLinkerVersion() uint8
+	SizeOfHeaders() uint32
+	CheckSum() uint32
 	Subsystem() Subsystem
 	DataDirectories() []DataDirectory
 }
@@ -16,6 +20,41 @@ const MinOptionalHeaderSize32 = 96
 // PE/COFF file that uses the PE32 format.
 type OptionalHeader32 []byte
 
+// MajorLinkerVersion returns the major version number of the linker that
+// produced the image.
+func (header OptionalHeader32) MajorLinkerVersion() uint8 {
+	if len(header) < MinOptionalHeaderSize32 {
+		return 0
+	}
+	return header[2]
+}
+
+// MinorLinkerVersion returns the minor version number of the linker that
+// produced the image.
+func (header OptionalHeader32) MinorLinkerVersion() uint8 {
+	if len(header) < MinOptionalHeaderSize32 {
+		return 0
+	}
+	return header[3]
+}
+
+// SizeOfHeaders returns the combined size of the DOS stub, PE header and
+// section headers, rounded up to a multiple of the file alignment.
+func (header OptionalHeader32) SizeOfHeaders() uint32 {
+	if len(header) < MinOptionalHeaderSize32 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(header[60:64])
+}
+
+// CheckSum returns the image checksum stored in the header.
+func (header OptionalHeader32) CheckSum() uint32 {
+	if len(header) < MinOptionalHeaderSize32 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(header[64:68])
+}
+
 // Subsystem returns the subsystem responsible for executing the image.
 func (header OptionalHeader32) Subsystem() Subsystem {
 	if len(header) < MinOptionalHeaderSize32 {
@@ -48,6 +87,41 @@ const MinOptionalHeaderSize64 = 112
 // PE/COFF file that uses the PE32+ format.
 type OptionalHeader64 []byte
 
+// MajorLinkerVersion returns the major version number of the linker that
+// produced the image.
+func (header OptionalHeader64) MajorLinkerVersion() uint8 {
+	if len(header) < MinOptionalHeaderSize64 {
+		return 0
+	}
+	return header[2]
+}
+
+// MinorLinkerVersion returns the minor version number of the linker that
+// produced the image.
+func (header OptionalHeader64) MinorLinkerVersion() uint8 {
+	if len(header) < MinOptionalHeaderSize64 {
+		return 0
+	}
+	return header[3]
+}
+
+// SizeOfHeaders returns the combined size of the DOS stub, PE header and
+// section headers, rounded up to a multiple of the file alignment.
+func (header OptionalHeader64) SizeOfHeaders() uint32 {
+	if len(header) < MinOptionalHeaderSize64 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(header[60:64])
+}
+
+// CheckSum returns the image checksum stored in the header.
+func (header OptionalHeader64) CheckSum() uint32 {
+	if len(header) < MinOptionalHeaderSize64 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(header[64:68])
+}
+
 // Subsystem returns the subsystem responsible for executing the image.
 func (header OptionalHeader64) Subsystem() Subsystem {
 	if len(header) < MinOptionalHeaderSize64 {
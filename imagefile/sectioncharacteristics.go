@@ -0,0 +1,39 @@
+package imagefile
+
+// SectionCharacteristics holds the flags that describe a section.
+type SectionCharacteristics uint32
+
+// Section characteristics.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#section-flags
+const (
+	SectionNoPad               SectionCharacteristics = 0x00000008 // IMAGE_SCN_TYPE_NO_PAD, The section should not be padded to the next boundary (object files only)
+	SectionCode                SectionCharacteristics = 0x00000020 // IMAGE_SCN_CNT_CODE, The section contains executable code
+	SectionInitializedData     SectionCharacteristics = 0x00000040 // IMAGE_SCN_CNT_INITIALIZED_DATA, The section contains initialized data
+	SectionUninitializedData   SectionCharacteristics = 0x00000080 // IMAGE_SCN_CNT_UNINITIALIZED_DATA, The section contains uninitialized data
+	SectionLinkOther           SectionCharacteristics = 0x00000100 // IMAGE_SCN_LNK_OTHER, Reserved
+	SectionLinkInfo            SectionCharacteristics = 0x00000200 // IMAGE_SCN_LNK_INFO, The section contains comments or other information, such as linker directives (object files only)
+	SectionLinkRemove          SectionCharacteristics = 0x00000800 // IMAGE_SCN_LNK_REMOVE, The section will not become part of the image (object files only)
+	SectionLinkCOMDAT          SectionCharacteristics = 0x00001000 // IMAGE_SCN_LNK_COMDAT, The section contains COMDAT data (object files only)
+	SectionGPRelative          SectionCharacteristics = 0x00008000 // IMAGE_SCN_GPREL, The section contains data referenced through the global pointer
+	SectionExtendedRelocations SectionCharacteristics = 0x01000000 // IMAGE_SCN_LNK_NRELOC_OVFL, The section contains extended relocations
+	SectionMemoryDiscardable   SectionCharacteristics = 0x02000000 // IMAGE_SCN_MEM_DISCARDABLE, The section can be discarded as needed
+	SectionMemoryNotCached     SectionCharacteristics = 0x04000000 // IMAGE_SCN_MEM_NOT_CACHED, The section cannot be cached
+	SectionMemoryNotPaged      SectionCharacteristics = 0x08000000 // IMAGE_SCN_MEM_NOT_PAGED, The section is not pageable
+	SectionMemoryShared        SectionCharacteristics = 0x10000000 // IMAGE_SCN_MEM_SHARED, The section can be shared in memory
+	SectionMemoryExecute       SectionCharacteristics = 0x20000000 // IMAGE_SCN_MEM_EXECUTE, The section can be executed as code
+	SectionMemoryRead          SectionCharacteristics = 0x40000000 // IMAGE_SCN_MEM_READ, The section can be read
+	SectionMemoryWrite         SectionCharacteristics = 0x80000000 // IMAGE_SCN_MEM_WRITE, The section can be written to
+	sectionAlignMask           SectionCharacteristics = 0x00F00000 // IMAGE_SCN_ALIGN_MASK
+)
+
+// Alignment returns the alignment of the section's data in bytes, as
+// declared by its IMAGE_SCN_ALIGN flags. It returns zero if no alignment is
+// declared. The alignment is only meaningful for object files.
+func (c SectionCharacteristics) Alignment() uint {
+	shift := uint(c&sectionAlignMask) >> 20
+	if shift == 0 {
+		return 0
+	}
+	return 1 << (shift - 1)
+}
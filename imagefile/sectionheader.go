@@ -61,3 +61,33 @@ func (header SectionHeader) SizeOfRawData() uint {
 func (header SectionHeader) PointerToRawData() FileOffset {
 	return FileOffset(binary.LittleEndian.Uint32(header[20:24]))
 }
+
+// PointerToRelocations returns the start of the section's relocation
+// entries. It is zero for image files.
+func (header SectionHeader) PointerToRelocations() FileOffset {
+	return FileOffset(binary.LittleEndian.Uint32(header[24:28]))
+}
+
+// PointerToLinenumbers returns the start of the section's line number
+// entries, which are deprecated.
+func (header SectionHeader) PointerToLinenumbers() FileOffset {
+	return FileOffset(binary.LittleEndian.Uint32(header[28:32]))
+}
+
+// NumberOfRelocations returns the number of relocation entries for the
+// section. See [SectionExtendedRelocations] for sections with more than
+// 65535 relocations.
+func (header SectionHeader) NumberOfRelocations() uint16 {
+	return binary.LittleEndian.Uint16(header[32:34])
+}
+
+// NumberOfLinenumbers returns the number of line number entries for the
+// section.
+func (header SectionHeader) NumberOfLinenumbers() uint16 {
+	return binary.LittleEndian.Uint16(header[34:36])
+}
+
+// Characteristics returns the flags that describe the section.
+func (header SectionHeader) Characteristics() SectionCharacteristics {
+	return SectionCharacteristics(binary.LittleEndian.Uint32(header[36:40]))
+}
@@ -0,0 +1,15 @@
+package bytesconv
+
+// DecodeANSI interprets the given bytes as single-byte characters and
+// returns the value as a string.
+//
+// The bytes are decoded as Latin-1 (ISO 8859-1), which matches the first
+// 256 code points of Unicode. Characters that differ in the Windows code
+// page used to produce the data may not be decoded faithfully.
+func DecodeANSI(p []byte) string {
+	buf := make([]rune, len(p))
+	for i, b := range p {
+		buf[i] = rune(b)
+	}
+	return string(buf)
+}
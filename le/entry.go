@@ -0,0 +1,158 @@
+package le
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EntryType identifies the type of an entry in the entry table of a linear
+// executable.
+type EntryType uint8
+
+// Entry types.
+const (
+	EntryUnused    EntryType = 0 // An unused ordinal
+	Entry16Bit     EntryType = 1 // A 16-bit offset within an object
+	EntryCallGate  EntryType = 2 // A 16-bit offset reached through a 286 call gate
+	Entry32Bit     EntryType = 3 // A 32-bit offset within an object
+	EntryForwarder EntryType = 4 // A reference to an entry in another module
+)
+
+// String returns a string representation of the entry type.
+func (t EntryType) String() string {
+	switch t {
+	case EntryUnused:
+		return "Unused"
+	case Entry16Bit:
+		return "16-bit"
+	case EntryCallGate:
+		return "Call Gate"
+	case Entry32Bit:
+		return "32-bit"
+	case EntryForwarder:
+		return "Forwarder"
+	default:
+		return fmt.Sprintf("<unrecognized entry type: %d>", uint8(t))
+	}
+}
+
+// entryTypeMask selects the bits of a bundle type that hold the entry
+// type. The remaining bit indicates that parameter type information is
+// present.
+const entryTypeMask = 0x7F
+
+// entrySize returns the size of each entry in a bundle of the given type.
+func entrySize(t EntryType) int {
+	switch t {
+	case Entry16Bit:
+		return 3
+	case EntryCallGate, Entry32Bit:
+		return 5
+	case EntryForwarder:
+		return 7
+	default:
+		return 0
+	}
+}
+
+// Entry flags.
+const (
+	EntryExported    = 0x01 // The entry is exported
+	EntrySharedData  = 0x02 // The entry uses a shared data segment (16-bit entries)
+	ForwardByOrdinal = 0x01 // The forwarder refers to its target by ordinal (forwarder entries)
+)
+
+// Entry is an entry point in the entry table of a linear executable. Unused
+// ordinals are omitted.
+type Entry struct {
+	// Ordinal is the ordinal of the entry.
+	Ordinal uint32
+
+	// Type is the type of the entry.
+	Type EntryType
+
+	// Flags holds the entry flags.
+	Flags uint8
+
+	// Object is the 1-based number of the object that holds the entry. It
+	// is zero for forwarders.
+	Object uint16
+
+	// Offset is the offset of the entry within its object. For forwarders
+	// it is the ordinal of the target if [ForwardByOrdinal] is set in the
+	// flags, otherwise it is the offset of the target's name in the import
+	// procedure name table.
+	Offset uint32
+
+	// CallGate is the call gate selector for call gate entries.
+	CallGate uint16
+
+	// Module is the 1-based index of the target module in the import module
+	// table for forwarders.
+	Module uint16
+}
+
+// parseEntries parses the bundles of an entry table. The table ends with a
+// bundle that has a count of zero.
+func parseEntries(data []byte) ([]Entry, error) {
+	var entries []Entry
+	ordinal := uint32(1)
+	offset := 0
+	for {
+		if offset >= len(data) {
+			return nil, fmt.Errorf("the entry table is not terminated")
+		}
+		count := int(data[offset])
+		if count == 0 {
+			break
+		}
+		if offset+2 > len(data) {
+			return nil, fmt.Errorf("the entry bundle at offset %d exceeds the bounds of the entry table", offset)
+		}
+		typ := EntryType(data[offset+1] & entryTypeMask)
+		offset += 2
+
+		if typ == EntryUnused {
+			ordinal += uint32(count)
+			continue
+		}
+
+		size := entrySize(typ)
+		if size == 0 {
+			return nil, fmt.Errorf("the entry bundle at offset %d has an unrecognized type of %d", offset-2, uint8(typ))
+		}
+		if offset+2+count*size > len(data) {
+			return nil, fmt.Errorf("the entry bundle at offset %d exceeds the bounds of the entry table", offset-2)
+		}
+		object := binary.LittleEndian.Uint16(data[offset:])
+		offset += 2
+
+		for range count {
+			b := data[offset : offset+size]
+			entry := Entry{
+				Ordinal: ordinal,
+				Type:    typ,
+				Flags:   b[0],
+			}
+			switch typ {
+			case Entry16Bit:
+				entry.Object = object
+				entry.Offset = uint32(binary.LittleEndian.Uint16(b[1:]))
+			case EntryCallGate:
+				entry.Object = object
+				entry.Offset = uint32(binary.LittleEndian.Uint16(b[1:]))
+				entry.CallGate = binary.LittleEndian.Uint16(b[3:])
+			case Entry32Bit:
+				entry.Object = object
+				entry.Offset = binary.LittleEndian.Uint32(b[1:])
+			case EntryForwarder:
+				entry.Module = binary.LittleEndian.Uint16(b[1:])
+				entry.Offset = binary.LittleEndian.Uint32(b[3:])
+			}
+			entries = append(entries, entry)
+			ordinal++
+			offset += size
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,290 @@
+package le
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// HeaderSize is the size of the LE and LX header.
+const HeaderSize = 176
+
+// Header is the header of a linear executable (LE or LX) file. It follows
+// the DOS header and stub.
+//
+// LE files are used by Windows virtual device drivers (VxDs) and some DOS
+// extenders. LX files are used by 32-bit OS/2. The formats share a header
+// layout, with minor differences in the interpretation of some fields.
+//
+// Unless noted otherwise, the offsets of the tables described by the
+// header are relative to the start of the header.
+type Header [HeaderSize]byte
+
+// Signature returns the two byte signature at the start of the header,
+// which is expected to hold "LE" or "LX".
+func (header *Header) Signature() string {
+	return string(header[0:2])
+}
+
+// IsLX returns true if the header is an LX header rather than an LE header.
+func (header *Header) IsLX() bool {
+	return header.Signature() == "LX"
+}
+
+// FormatLevel returns the version of the executable format.
+func (header *Header) FormatLevel() uint32 {
+	return binary.LittleEndian.Uint32(header[4:])
+}
+
+// CPU returns the type of CPU that the module requires.
+func (header *Header) CPU() CPU {
+	return CPU(binary.LittleEndian.Uint16(header[8:]))
+}
+
+// OperatingSystem returns the operating system that the module targets.
+func (header *Header) OperatingSystem() OperatingSystem {
+	return OperatingSystem(binary.LittleEndian.Uint16(header[10:]))
+}
+
+// ModuleVersion returns the version of the module.
+func (header *Header) ModuleVersion() uint32 {
+	return binary.LittleEndian.Uint32(header[12:])
+}
+
+// Flags returns the module flags.
+func (header *Header) Flags() Flags {
+	return Flags(binary.LittleEndian.Uint32(header[16:]))
+}
+
+// Pages returns the number of pages in the module.
+func (header *Header) Pages() uint32 {
+	return binary.LittleEndian.Uint32(header[20:])
+}
+
+// EntryPoint returns the 1-based object number and the offset of the
+// initial instruction pointer.
+func (header *Header) EntryPoint() (object, offset uint32) {
+	return binary.LittleEndian.Uint32(header[24:]), binary.LittleEndian.Uint32(header[28:])
+}
+
+// InitialStack returns the 1-based object number and the offset of the
+// initial stack pointer.
+func (header *Header) InitialStack() (object, offset uint32) {
+	return binary.LittleEndian.Uint32(header[32:]), binary.LittleEndian.Uint32(header[36:])
+}
+
+// PageSize returns the size of a page in bytes.
+func (header *Header) PageSize() uint32 {
+	return binary.LittleEndian.Uint32(header[40:])
+}
+
+// LoaderSectionSize returns the number of bytes in the loader section,
+// which begins with the object table.
+func (header *Header) LoaderSectionSize() uint32 {
+	return binary.LittleEndian.Uint32(header[56:])
+}
+
+// ObjectTable returns the offset of the object table.
+func (header *Header) ObjectTable() uint32 {
+	return binary.LittleEndian.Uint32(header[64:])
+}
+
+// Objects returns the number of entries in the object table.
+func (header *Header) Objects() uint32 {
+	return binary.LittleEndian.Uint32(header[68:])
+}
+
+// ObjectPageTable returns the offset of the object page table.
+func (header *Header) ObjectPageTable() uint32 {
+	return binary.LittleEndian.Uint32(header[72:])
+}
+
+// ResourceTable returns the offset of the resource table.
+func (header *Header) ResourceTable() uint32 {
+	return binary.LittleEndian.Uint32(header[80:])
+}
+
+// Resources returns the number of entries in the resource table.
+func (header *Header) Resources() uint32 {
+	return binary.LittleEndian.Uint32(header[84:])
+}
+
+// ResidentNameTable returns the offset of the resident name table.
+func (header *Header) ResidentNameTable() uint32 {
+	return binary.LittleEndian.Uint32(header[88:])
+}
+
+// EntryTable returns the offset of the entry table.
+func (header *Header) EntryTable() uint32 {
+	return binary.LittleEndian.Uint32(header[92:])
+}
+
+// FixupPageTable returns the offset of the fixup page table.
+func (header *Header) FixupPageTable() uint32 {
+	return binary.LittleEndian.Uint32(header[104:])
+}
+
+// ImportModuleTable returns the offset of the import module name table.
+func (header *Header) ImportModuleTable() uint32 {
+	return binary.LittleEndian.Uint32(header[112:])
+}
+
+// ImportModules returns the number of entries in the import module name
+// table.
+func (header *Header) ImportModules() uint32 {
+	return binary.LittleEndian.Uint32(header[116:])
+}
+
+// ImportProcedureTable returns the offset of the import procedure name
+// table.
+func (header *Header) ImportProcedureTable() uint32 {
+	return binary.LittleEndian.Uint32(header[120:])
+}
+
+// DataPages returns the offset of the first data page. Unlike the other
+// table offsets, it is relative to the start of the file.
+func (header *Header) DataPages() uint32 {
+	return binary.LittleEndian.Uint32(header[128:])
+}
+
+// NonResidentNameTable returns the offset of the non-resident name table.
+// Unlike the other table offsets, it is relative to the start of the file.
+func (header *Header) NonResidentNameTable() uint32 {
+	return binary.LittleEndian.Uint32(header[136:])
+}
+
+// NonResidentNameTableSize returns the number of bytes in the non-resident
+// name table.
+func (header *Header) NonResidentNameTableSize() uint32 {
+	return binary.LittleEndian.Uint32(header[140:])
+}
+
+// AutoDataObject returns the 1-based object number of the automatic data
+// object.
+func (header *Header) AutoDataObject() uint32 {
+	return binary.LittleEndian.Uint32(header[148:])
+}
+
+// HeapSize returns the size of the heap.
+func (header *Header) HeapSize() uint32 {
+	return binary.LittleEndian.Uint32(header[168:])
+}
+
+// StackSize returns the size of the stack.
+func (header *Header) StackSize() uint32 {
+	return binary.LittleEndian.Uint32(header[172:])
+}
+
+// CPU identifies the type of CPU required by a linear executable.
+type CPU uint16
+
+// CPU types.
+const (
+	CPU286     CPU = 0x01 // Intel 80286
+	CPU386     CPU = 0x02 // Intel 80386
+	CPU486     CPU = 0x03 // Intel 80486
+	CPUPentium CPU = 0x04 // Intel Pentium
+	CPUi860N10 CPU = 0x20 // Intel i860 (N10)
+	CPUi860N11 CPU = 0x21 // Intel i860 (N11)
+	CPUR2000   CPU = 0x40 // MIPS Mark I (R2000, R3000)
+	CPUR6000   CPU = 0x41 // MIPS Mark II (R6000)
+	CPUR4000   CPU = 0x42 // MIPS Mark III (R4000)
+)
+
+// String returns a string representation of the CPU type.
+func (cpu CPU) String() string {
+	switch cpu {
+	case CPU286:
+		return "80286"
+	case CPU386:
+		return "80386"
+	case CPU486:
+		return "80486"
+	case CPUPentium:
+		return "Pentium"
+	case CPUi860N10:
+		return "i860 N10"
+	case CPUi860N11:
+		return "i860 N11"
+	case CPUR2000:
+		return "MIPS R2000"
+	case CPUR6000:
+		return "MIPS R6000"
+	case CPUR4000:
+		return "MIPS R4000"
+	default:
+		return fmt.Sprintf("<unrecognized CPU: %x>", uint16(cpu))
+	}
+}
+
+// OperatingSystem identifies the operating system targeted by a linear
+// executable.
+type OperatingSystem uint16
+
+// Target operating systems.
+const (
+	OSUnknown OperatingSystem = 0 // Unknown
+	OSOS2     OperatingSystem = 1 // OS/2
+	OSWindows OperatingSystem = 2 // Windows
+	OSDOS4    OperatingSystem = 3 // European MS-DOS 4.x
+	OSWin386  OperatingSystem = 4 // Windows 386, used by VxDs
+)
+
+// String returns a string representation of the operating system.
+func (os OperatingSystem) String() string {
+	switch os {
+	case OSUnknown:
+		return "Unknown"
+	case OSOS2:
+		return "OS/2"
+	case OSWindows:
+		return "Windows"
+	case OSDOS4:
+		return "DOS 4"
+	case OSWin386:
+		return "Windows 386"
+	default:
+		return fmt.Sprintf("<unrecognized operating system: %d>", uint16(os))
+	}
+}
+
+// Flags holds the module flags of a linear executable.
+type Flags uint32
+
+// moduleTypeMask selects the bits of the module flags that hold the module
+// type.
+const moduleTypeMask = 0x38000
+
+// ModuleType returns the type of module described by the flags.
+func (flags Flags) ModuleType() ModuleType {
+	return ModuleType(flags & moduleTypeMask)
+}
+
+// ModuleType identifies the type of a linear executable module.
+type ModuleType uint32
+
+// Module types.
+const (
+	ModuleProgram                ModuleType = 0x00000 // A program
+	ModuleLibrary                ModuleType = 0x08000 // A library
+	ModuleProtectedMemoryLibrary ModuleType = 0x18000 // A protected memory library
+	ModulePhysicalDeviceDriver   ModuleType = 0x20000 // A physical device driver
+	ModuleVirtualDeviceDriver    ModuleType = 0x28000 // A virtual device driver
+)
+
+// String returns a string representation of the module type.
+func (t ModuleType) String() string {
+	switch t {
+	case ModuleProgram:
+		return "Program"
+	case ModuleLibrary:
+		return "Library"
+	case ModuleProtectedMemoryLibrary:
+		return "Protected Memory Library"
+	case ModulePhysicalDeviceDriver:
+		return "Physical Device Driver"
+	case ModuleVirtualDeviceDriver:
+		return "Virtual Device Driver"
+	default:
+		return fmt.Sprintf("<unrecognized module type: %x>", uint32(t))
+	}
+}
@@ -0,0 +1,59 @@
+package le
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/internal/bytesconv"
+)
+
+// Name is an entry in the resident or non-resident name table of a linear
+// executable. It associates an exported name with an ordinal in the entry
+// table.
+type Name struct {
+	Name    string
+	Ordinal uint16
+}
+
+// String returns a string representation of the name.
+func (name Name) String() string {
+	return fmt.Sprintf("%s @%d", name.Name, name.Ordinal)
+}
+
+// parseNames parses a name table, which is a sequence of length-prefixed
+// strings that are each followed by an ordinal. The table ends with an
+// entry of zero length or at the end of the data.
+func parseNames(data []byte) ([]Name, error) {
+	var names []Name
+	for offset := 0; offset < len(data) && data[offset] != 0; {
+		length := int(data[offset])
+		end := offset + 1 + length + 2
+		if end > len(data) {
+			return nil, fmt.Errorf("the name at offset %d of the name table exceeds the bounds of the table", offset)
+		}
+		names = append(names, Name{
+			Name:    bytesconv.DecodeANSI(data[offset+1 : offset+1+length]),
+			Ordinal: binary.LittleEndian.Uint16(data[end-2:]),
+		})
+		offset = end
+	}
+	return names, nil
+}
+
+// parseStrings parses count length-prefixed strings from data.
+func parseStrings(data []byte, count int) ([]string, error) {
+	strs := make([]string, 0, count)
+	offset := 0
+	for range count {
+		if offset >= len(data) {
+			return nil, fmt.Errorf("the string at offset %d exceeds the bounds of the table", offset)
+		}
+		end := offset + 1 + int(data[offset])
+		if end > len(data) {
+			return nil, fmt.Errorf("the string at offset %d exceeds the bounds of the table", offset)
+		}
+		strs = append(strs, bytesconv.DecodeANSI(data[offset+1:end]))
+		offset = end
+	}
+	return strs, nil
+}
@@ -0,0 +1,71 @@
+package le
+
+import "encoding/binary"
+
+// objectEntrySize is the size of an entry in the object table.
+const objectEntrySize = 24
+
+// ObjectFlags holds the attributes of an object.
+type ObjectFlags uint32
+
+// Object flags.
+const (
+	ObjectReadable    ObjectFlags = 0x0001 // The object is readable
+	ObjectWritable    ObjectFlags = 0x0002 // The object is writable
+	ObjectExecutable  ObjectFlags = 0x0004 // The object is executable
+	ObjectResource    ObjectFlags = 0x0008 // The object holds resources
+	ObjectDiscardable ObjectFlags = 0x0010 // The object can be discarded
+	ObjectShared      ObjectFlags = 0x0020 // The object is shared
+	ObjectPreload     ObjectFlags = 0x0040 // The object has preload pages
+	ObjectInvalid     ObjectFlags = 0x0080 // The object has invalid pages
+	ObjectZeroFilled  ObjectFlags = 0x0100 // The object has zero-filled pages
+	ObjectAlias       ObjectFlags = 0x1000 // The object requires a 16:16 alias
+	ObjectBig         ObjectFlags = 0x2000 // The object uses 32-bit addressing
+	ObjectConforming  ObjectFlags = 0x4000 // The object is conforming code
+	ObjectIOPrivilege ObjectFlags = 0x8000 // The object has I/O privilege
+)
+
+// Object is an entry in the object table of a linear executable. Objects
+// are the linear executable equivalent of segments and sections.
+type Object struct {
+	// VirtualSize is the size of the object in memory.
+	VirtualSize uint32
+
+	// BaseAddress is the address that the object is relocated to by
+	// default.
+	BaseAddress uint32
+
+	// Flags holds the attributes of the object.
+	Flags ObjectFlags
+
+	// PageTableIndex is the 1-based index of the object's first entry in
+	// the object page table.
+	PageTableIndex uint32
+
+	// PageCount is the number of entries in the object page table that
+	// belong to the object.
+	PageCount uint32
+}
+
+// objectEntry is an entry in the object table.
+type objectEntry []byte
+
+func (entry objectEntry) VirtualSize() uint32 {
+	return binary.LittleEndian.Uint32(entry[0:4])
+}
+
+func (entry objectEntry) BaseAddress() uint32 {
+	return binary.LittleEndian.Uint32(entry[4:8])
+}
+
+func (entry objectEntry) Flags() ObjectFlags {
+	return ObjectFlags(binary.LittleEndian.Uint32(entry[8:12]))
+}
+
+func (entry objectEntry) PageTableIndex() uint32 {
+	return binary.LittleEndian.Uint32(entry[12:16])
+}
+
+func (entry objectEntry) PageCount() uint32 {
+	return binary.LittleEndian.Uint32(entry[16:20])
+}
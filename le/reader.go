@@ -0,0 +1,175 @@
+package le
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable/dos"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// maxTableSize limits the amount of data read for tables whose size is not
+// recorded in the header.
+const maxTableSize = 0x10000
+
+// Reader reads linear executable (LE and LX) file data from an underlying
+// [io.ReaderAt].
+type Reader struct {
+	source io.ReaderAt
+	offset imagefile.FileOffset
+	header Header
+}
+
+// NewReader creates and initializes a new linear executable [Reader] that
+// reads from source.
+//
+// It reads and validates the DOS header and the LE or LX header that
+// follows it. If the validation fails it returns an error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	dosReader, err := dos.NewReader(source)
+	if err != nil {
+		return nil, err
+	}
+	if kind, err := dosReader.Kind(); err != nil {
+		return nil, err
+	} else if kind != dos.KindLE && kind != dos.KindLX {
+		return nil, fmt.Errorf("the file is a %s executable instead of an LE or LX executable", kind)
+	}
+
+	dosHeader := dosReader.Header()
+	r := &Reader{
+		source: source,
+		offset: dosHeader.NextHeader(),
+	}
+	if _, err := source.ReadAt(r.header[:], int64(r.offset)); err != nil {
+		return nil, fmt.Errorf("failed to read the linear executable header at offset %s: %w", r.offset, err)
+	}
+	return r, nil
+}
+
+// Header returns the LE or LX header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// HeaderOffset returns the file offset of the LE or LX header. The offsets
+// of most tables are relative to it.
+func (r *Reader) HeaderOffset() imagefile.FileOffset {
+	return r.offset
+}
+
+// ReadObjects reads the entries of the object table.
+func (r *Reader) ReadObjects() ([]Object, error) {
+	count := r.header.Objects()
+	if count > maxTableSize/objectEntrySize {
+		return nil, fmt.Errorf("the object table has too many entries: %d", count)
+	}
+	data, err := r.readTable("object table", r.header.ObjectTable(), int(count)*objectEntrySize, false)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]Object, count)
+	for i := range objects {
+		entry := objectEntry(data[i*objectEntrySize : (i+1)*objectEntrySize])
+		objects[i] = Object{
+			VirtualSize:    entry.VirtualSize(),
+			BaseAddress:    entry.BaseAddress(),
+			Flags:          entry.Flags(),
+			PageTableIndex: entry.PageTableIndex(),
+			PageCount:      entry.PageCount(),
+		}
+	}
+	return objects, nil
+}
+
+// ReadResidentNames reads the entries of the resident name table. The first
+// entry holds the module name.
+func (r *Reader) ReadResidentNames() ([]Name, error) {
+	start, end := r.header.ResidentNameTable(), r.header.EntryTable()
+	if end < start {
+		return nil, fmt.Errorf("the resident name table has an invalid length")
+	}
+	data, err := r.readTable("resident name table", start, int(min(end-start, maxTableSize)), false)
+	if err != nil {
+		return nil, err
+	}
+	return parseNames(data)
+}
+
+// ReadNonResidentNames reads the entries of the non-resident name table. The
+// first entry holds the module description.
+func (r *Reader) ReadNonResidentNames() ([]Name, error) {
+	size := r.header.NonResidentNameTableSize()
+	if size == 0 {
+		return nil, nil
+	}
+	data := make([]byte, min(size, maxTableSize))
+	start := imagefile.FileOffset(r.header.NonResidentNameTable())
+	if _, err := r.source.ReadAt(data, int64(start)); err != nil {
+		return nil, fmt.Errorf("failed to read the non-resident name table at offset %s: %w", start, err)
+	}
+	return parseNames(data)
+}
+
+// ReadModuleName reads the name of the module from the resident name table.
+func (r *Reader) ReadModuleName() (string, error) {
+	names, err := r.ReadResidentNames()
+	if err != nil {
+		return "", err
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("the resident name table is empty")
+	}
+	return names[0].Name, nil
+}
+
+// ReadEntries reads the entries of the entry table.
+func (r *Reader) ReadEntries() ([]Entry, error) {
+	start := r.header.EntryTable()
+	length := maxTableSize
+	if end := r.header.ObjectTable() + r.header.LoaderSectionSize(); end > start {
+		length = int(min(end-start, maxTableSize))
+	}
+	data, err := r.readTable("entry table", start, length, true)
+	if err != nil {
+		return nil, err
+	}
+	return parseEntries(data)
+}
+
+// ReadImportModules reads the names of the modules that the file imports
+// from, in the order of the import module name table.
+func (r *Reader) ReadImportModules() ([]string, error) {
+	count := r.header.ImportModules()
+	if count == 0 {
+		return nil, nil
+	}
+	start := r.header.ImportModuleTable()
+	length := maxTableSize
+	if end := r.header.ImportProcedureTable(); end > start {
+		length = int(min(end-start, maxTableSize))
+	}
+	data, err := r.readTable("import module name table", start, length, true)
+	if err != nil {
+		return nil, err
+	}
+	return parseStrings(data, int(count))
+}
+
+// readTable reads length bytes of the table at the given offset, which is
+// relative to the LE or LX header. If partial is true the table is
+// truncated at the end of the file instead of returning an error.
+func (r *Reader) readTable(name string, offset uint32, length int, partial bool) ([]byte, error) {
+	data := make([]byte, length)
+	if length == 0 {
+		return data, nil
+	}
+	start := r.offset + imagefile.FileOffset(offset)
+	n, err := r.source.ReadAt(data, int64(start))
+	if err != nil && !(partial && errors.Is(err, io.EOF)) {
+		return nil, fmt.Errorf("failed to read the %s at offset %s: %w", name, start, err)
+	}
+	return data[:n], nil
+}
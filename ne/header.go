@@ -0,0 +1,209 @@
+package ne
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// HeaderSize is the size of the NE header.
+const HeaderSize = 64
+
+// Header is the 64 byte header of a 16-bit new executable (NE) file. It
+// follows the DOS header and stub. It is the IMAGE_OS2_HEADER structure.
+//
+// Unless noted otherwise, the offsets of the tables described by the
+// header are relative to the start of the header.
+type Header [HeaderSize]byte
+
+// Signature returns the two byte signature at the start of the header,
+// which is expected to hold "NE" (ne_magic).
+func (header *Header) Signature() string {
+	return string(header[0:2])
+}
+
+// LinkerVersion returns the major and minor version of the linker that
+// produced the file (ne_ver, ne_rev).
+func (header *Header) LinkerVersion() (major, minor uint8) {
+	return header[2], header[3]
+}
+
+// EntryTable returns the offset of the entry table (ne_enttab).
+func (header *Header) EntryTable() uint16 {
+	return binary.LittleEndian.Uint16(header[4:])
+}
+
+// EntryTableSize returns the number of bytes in the entry table
+// (ne_cbenttab).
+func (header *Header) EntryTableSize() uint16 {
+	return binary.LittleEndian.Uint16(header[6:])
+}
+
+// CheckSum returns the checksum of the file (ne_crc).
+func (header *Header) CheckSum() uint32 {
+	return binary.LittleEndian.Uint32(header[8:])
+}
+
+// Flags returns the module flags (ne_flags).
+func (header *Header) Flags() Flags {
+	return Flags(binary.LittleEndian.Uint16(header[12:]))
+}
+
+// AutoDataSegment returns the 1-based number of the automatic data segment
+// (ne_autodata).
+func (header *Header) AutoDataSegment() uint16 {
+	return binary.LittleEndian.Uint16(header[14:])
+}
+
+// HeapSize returns the initial size of the local heap (ne_heap).
+func (header *Header) HeapSize() uint16 {
+	return binary.LittleEndian.Uint16(header[16:])
+}
+
+// StackSize returns the initial size of the stack (ne_stack).
+func (header *Header) StackSize() uint16 {
+	return binary.LittleEndian.Uint16(header[18:])
+}
+
+// EntryPoint returns the initial CS:IP, where the segment is a 1-based
+// segment number (ne_csip).
+func (header *Header) EntryPoint() (segment, offset uint16) {
+	return binary.LittleEndian.Uint16(header[22:]), binary.LittleEndian.Uint16(header[20:])
+}
+
+// InitialStack returns the initial SS:SP, where the segment is a 1-based
+// segment number (ne_sssp).
+func (header *Header) InitialStack() (segment, offset uint16) {
+	return binary.LittleEndian.Uint16(header[26:]), binary.LittleEndian.Uint16(header[24:])
+}
+
+// Segments returns the number of entries in the segment table (ne_cseg).
+func (header *Header) Segments() uint16 {
+	return binary.LittleEndian.Uint16(header[28:])
+}
+
+// ModuleReferences returns the number of entries in the module reference
+// table (ne_cmod).
+func (header *Header) ModuleReferences() uint16 {
+	return binary.LittleEndian.Uint16(header[30:])
+}
+
+// NonResidentNameTableSize returns the number of bytes in the non-resident
+// name table (ne_cbnrestab).
+func (header *Header) NonResidentNameTableSize() uint16 {
+	return binary.LittleEndian.Uint16(header[32:])
+}
+
+// SegmentTable returns the offset of the segment table (ne_segtab).
+func (header *Header) SegmentTable() uint16 {
+	return binary.LittleEndian.Uint16(header[34:])
+}
+
+// ResourceTable returns the offset of the resource table (ne_rsrctab).
+func (header *Header) ResourceTable() uint16 {
+	return binary.LittleEndian.Uint16(header[36:])
+}
+
+// ResidentNameTable returns the offset of the resident name table
+// (ne_restab).
+func (header *Header) ResidentNameTable() uint16 {
+	return binary.LittleEndian.Uint16(header[38:])
+}
+
+// ModuleReferenceTable returns the offset of the module reference table
+// (ne_modtab).
+func (header *Header) ModuleReferenceTable() uint16 {
+	return binary.LittleEndian.Uint16(header[40:])
+}
+
+// ImportedNameTable returns the offset of the imported name table
+// (ne_imptab).
+func (header *Header) ImportedNameTable() uint16 {
+	return binary.LittleEndian.Uint16(header[42:])
+}
+
+// NonResidentNameTable returns the offset of the non-resident name table
+// (ne_nrestab). Unlike the other table offsets, it is relative to the
+// start of the file.
+func (header *Header) NonResidentNameTable() uint32 {
+	return binary.LittleEndian.Uint32(header[44:])
+}
+
+// MovableEntries returns the number of movable entries in the entry table
+// (ne_cmovent).
+func (header *Header) MovableEntries() uint16 {
+	return binary.LittleEndian.Uint16(header[48:])
+}
+
+// AlignmentShift returns the logical sector alignment shift count used to
+// express segment offsets (ne_align). A value of zero is equivalent to 9,
+// which yields 512 byte sectors.
+func (header *Header) AlignmentShift() uint16 {
+	return binary.LittleEndian.Uint16(header[50:])
+}
+
+// ResourceSegments returns the number of resource segments (ne_cres).
+func (header *Header) ResourceSegments() uint16 {
+	return binary.LittleEndian.Uint16(header[52:])
+}
+
+// OperatingSystem returns the operating system that the file targets
+// (ne_exetyp).
+func (header *Header) OperatingSystem() OperatingSystem {
+	return OperatingSystem(header[54])
+}
+
+// ExpectedWindowsVersion returns the version of Windows that the file
+// expects (ne_expver).
+func (header *Header) ExpectedWindowsVersion() (major, minor uint8) {
+	return header[63], header[62]
+}
+
+// Flags holds the module flags of an NE file.
+type Flags uint16
+
+// Module flags.
+const (
+	FlagSingleData    Flags = 0x0001 // NESOLO, The module has a single shared data segment
+	FlagMultipleData  Flags = 0x0002 // NEINST, The module has a data segment for each instance
+	FlagProtectedMode Flags = 0x0008 // NEPROT, The module runs only in protected mode
+	FlagLinkErrors    Flags = 0x2000 // NEIERR, The linker reported errors
+	FlagLibrary       Flags = 0x8000 // NENOTP, The module is a library
+)
+
+// IsLibrary returns true if the module is a library rather than a program.
+func (flags Flags) IsLibrary() bool {
+	return flags&FlagLibrary != 0
+}
+
+// OperatingSystem identifies the operating system targeted by an NE file.
+type OperatingSystem uint8
+
+// Target operating systems.
+const (
+	OSUnknown OperatingSystem = 0 // Unknown, typically Windows
+	OSOS2     OperatingSystem = 1 // OS/2
+	OSWindows OperatingSystem = 2 // Windows
+	OSDOS4    OperatingSystem = 3 // European MS-DOS 4.x
+	OSWin386  OperatingSystem = 4 // Windows 386
+	OSBOSS    OperatingSystem = 5 // Borland Operating System Services
+)
+
+// String returns a string representation of the operating system.
+func (os OperatingSystem) String() string {
+	switch os {
+	case OSUnknown:
+		return "Unknown"
+	case OSOS2:
+		return "OS/2"
+	case OSWindows:
+		return "Windows"
+	case OSDOS4:
+		return "DOS 4"
+	case OSWin386:
+		return "Windows 386"
+	case OSBOSS:
+		return "BOSS"
+	default:
+		return fmt.Sprintf("<unrecognized operating system: %d>", uint8(os))
+	}
+}
@@ -0,0 +1,238 @@
+package ne
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable/dos"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory/resourcetype"
+	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory/resourcetype/versioninfo"
+)
+
+// ErrMissingVersionInfo is returned by [Reader.ReadVersionInfo] if the file
+// does not have a version resource.
+var ErrMissingVersionInfo = errors.New("the NE file does not have a version resource")
+
+// Reader reads 16-bit new executable (NE) file data from an underlying
+// [io.ReaderAt].
+type Reader struct {
+	source io.ReaderAt
+	offset imagefile.FileOffset
+	header Header
+}
+
+// NewReader creates and initializes a new NE file [Reader] that reads from
+// source.
+//
+// It reads and validates the DOS header and the NE header that follows it.
+// If the validation fails it returns an error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	dosReader, err := dos.NewReader(source)
+	if err != nil {
+		return nil, err
+	}
+	if kind, err := dosReader.Kind(); err != nil {
+		return nil, err
+	} else if kind != dos.KindNE {
+		return nil, fmt.Errorf("the file is a %s executable instead of an NE executable", kind)
+	}
+
+	dosHeader := dosReader.Header()
+	r := &Reader{
+		source: source,
+		offset: dosHeader.NextHeader(),
+	}
+	if _, err := source.ReadAt(r.header[:], int64(r.offset)); err != nil {
+		return nil, fmt.Errorf("failed to read the NE header at offset %s: %w", r.offset, err)
+	}
+	return r, nil
+}
+
+// Header returns the NE header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// HeaderOffset returns the file offset of the NE header. The offsets of
+// most tables are relative to it.
+func (r *Reader) HeaderOffset() imagefile.FileOffset {
+	return r.offset
+}
+
+// ReadSegments reads the entries of the segment table.
+func (r *Reader) ReadSegments() ([]Segment, error) {
+	count := int(r.header.Segments())
+	data, err := r.readTable("segment table", r.header.SegmentTable(), count*segmentEntrySize)
+	if err != nil {
+		return nil, err
+	}
+
+	shift := r.header.AlignmentShift()
+	if shift == 0 {
+		shift = 9
+	}
+	if shift > 31 {
+		return nil, fmt.Errorf("the NE header has an invalid alignment shift of %d", shift)
+	}
+
+	segments := make([]Segment, count)
+	for i := range segments {
+		entry := segmentEntry(data[i*segmentEntrySize : (i+1)*segmentEntrySize])
+		segments[i] = Segment{
+			Flags:    entry.Flags(),
+			MinAlloc: entry.MinAlloc(),
+		}
+		if sector := entry.Sector(); sector != 0 {
+			segments[i].Location = imagefile.FileRange{
+				Start:  imagefile.FileOffset(uint(sector) << shift),
+				Length: uint(entry.Length()),
+			}
+		}
+	}
+	return segments, nil
+}
+
+// ReadResidentNames reads the entries of the resident name table. The first
+// entry holds the module name.
+func (r *Reader) ReadResidentNames() ([]Name, error) {
+	start, end := r.header.ResidentNameTable(), r.header.ModuleReferenceTable()
+	if end < start {
+		return nil, fmt.Errorf("the resident name table has an invalid length")
+	}
+	data, err := r.readTable("resident name table", start, int(end-start))
+	if err != nil {
+		return nil, err
+	}
+	return parseNames(data)
+}
+
+// ReadNonResidentNames reads the entries of the non-resident name table. The
+// first entry holds the module description.
+func (r *Reader) ReadNonResidentNames() ([]Name, error) {
+	size := int(r.header.NonResidentNameTableSize())
+	if size == 0 {
+		return nil, nil
+	}
+	data := make([]byte, size)
+	start := imagefile.FileOffset(r.header.NonResidentNameTable())
+	if _, err := r.source.ReadAt(data, int64(start)); err != nil {
+		return nil, fmt.Errorf("failed to read the non-resident name table at offset %s: %w", start, err)
+	}
+	return parseNames(data)
+}
+
+// ReadModuleName reads the name of the module from the resident name table.
+func (r *Reader) ReadModuleName() (string, error) {
+	names, err := r.ReadResidentNames()
+	if err != nil {
+		return "", err
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("the resident name table is empty")
+	}
+	return names[0].Name, nil
+}
+
+// ReadModuleReferences reads the names of the modules that the file
+// imports from, in the order of the module reference table.
+func (r *Reader) ReadModuleReferences() ([]string, error) {
+	count := int(r.header.ModuleReferences())
+	if count == 0 {
+		return nil, nil
+	}
+	refs, err := r.readTable("module reference table", r.header.ModuleReferenceTable(), count*2)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := r.header.ImportedNameTable(), r.header.EntryTable()
+	if end < start {
+		return nil, fmt.Errorf("the imported name table has an invalid length")
+	}
+	names, err := r.readTable("imported name table", start, int(end-start))
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]string, count)
+	for i := range modules {
+		offset := int(binary.LittleEndian.Uint16(refs[i*2:]))
+		if modules[i], err = parseString(names, offset); err != nil {
+			return nil, fmt.Errorf("failed to read module reference %d: %w", i+1, err)
+		}
+	}
+	return modules, nil
+}
+
+// ReadResources reads the entries of the resource table.
+func (r *Reader) ReadResources() ([]Resource, error) {
+	start, end := r.header.ResourceTable(), r.header.ResidentNameTable()
+	if start == end {
+		return nil, nil
+	}
+	if end < start {
+		return nil, fmt.Errorf("the resource table has an invalid length")
+	}
+	data, err := r.readTable("resource table", start, int(end-start))
+	if err != nil {
+		return nil, err
+	}
+	return parseResources(data)
+}
+
+// ReadResourceData reads the data for the given resource.
+func (r *Reader) ReadResourceData(resource Resource) ([]byte, error) {
+	// The location is scaled by the alignment shift of the resource table,
+	// so a crafted file can declare a length far beyond the end of the
+	// file. Read the data incrementally instead of allocating the declared
+	// length up front.
+	section := io.NewSectionReader(r.source, int64(resource.Location.Start), int64(resource.Location.Length))
+	data, err := io.ReadAll(section)
+	if err == nil && uint(len(data)) < resource.Location.Length {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource %s of type %s at location %s: %w", resource.ID, resource.Type, resource.Location, err)
+	}
+	return data, nil
+}
+
+// ReadVersionInfo reads the first version resource of the file and parses
+// it as a version info tree in the 16-bit ANSI format.
+//
+// If the file does not have a version resource it returns
+// [ErrMissingVersionInfo].
+func (r *Reader) ReadVersionInfo() (versioninfo.Root, error) {
+	resources, err := r.ReadResources()
+	if err != nil {
+		return versioninfo.Root{}, err
+	}
+	for _, resource := range resources {
+		if !resource.Type.IsNumeric() || resource.Type.Number() != resourcetype.Version {
+			continue
+		}
+		data, err := r.ReadResourceData(resource)
+		if err != nil {
+			return versioninfo.Root{}, err
+		}
+		return versioninfo.NewANSIRoot(data)
+	}
+	return versioninfo.Root{}, ErrMissingVersionInfo
+}
+
+// readTable reads length bytes of the table at the given offset, which is
+// relative to the NE header.
+func (r *Reader) readTable(name string, offset uint16, length int) ([]byte, error) {
+	data := make([]byte, length)
+	if length == 0 {
+		return data, nil
+	}
+	start := r.offset + imagefile.FileOffset(offset)
+	if _, err := r.source.ReadAt(data, int64(start)); err != nil {
+		return nil, fmt.Errorf("failed to read the %s at offset %s: %w", name, start, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,116 @@
+package ne
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/resourcedirectory"
+)
+
+// ResourceFlags holds the attributes of a resource.
+type ResourceFlags uint16
+
+// Resource flags.
+const (
+	ResourceMovable ResourceFlags = 0x0010 // RNMOVE, The resource can be moved in memory
+	ResourcePure    ResourceFlags = 0x0020 // RNPURE, The resource is shared
+	ResourcePreload ResourceFlags = 0x0040 // RNPRELOAD, The resource is loaded when the module is loaded
+	ResourceDiscard ResourceFlags = 0x1000 // RNDISCARD, The resource can be discarded
+)
+
+// resourceIntegerFlag is set on resource types and names that are integers
+// rather than string offsets.
+const resourceIntegerFlag = 0x8000
+
+// Resource is an entry in the resource table of an NE file.
+type Resource struct {
+	// Type identifies the type of the resource. Numeric types use the same
+	// values as the resource types of portable executables. See the
+	// resourcetype package.
+	Type resourcedirectory.ID
+
+	// ID identifies the resource within its type.
+	ID resourcedirectory.ID
+
+	// Flags holds the attributes of the resource.
+	Flags ResourceFlags
+
+	// Location is the file range of the resource's data.
+	Location imagefile.FileRange
+}
+
+// Sizes of the structures within the resource table.
+const (
+	resourceTypeSize = 8  // TYPEINFO
+	resourceNameSize = 12 // NAMEINFO
+)
+
+// parseResources parses the resource table contained in data.
+func parseResources(data []byte) ([]Resource, error) {
+	if len(data) < 2 {
+		return nil, nil
+	}
+	shift := binary.LittleEndian.Uint16(data[0:2])
+	if shift > 31 {
+		return nil, fmt.Errorf("the resource table has an invalid alignment shift of %d", shift)
+	}
+
+	// readID interprets a type or name identifier, which is either an
+	// integer with its high bit set or the offset of a length-prefixed
+	// string relative to the start of the resource table.
+	readID := func(value uint16) (resourcedirectory.ID, error) {
+		if value&resourceIntegerFlag != 0 {
+			return resourcedirectory.NewNumericID(uint32(value &^ resourceIntegerFlag)), nil
+		}
+		str, err := parseString(data, int(value))
+		if err != nil {
+			return resourcedirectory.ID{}, fmt.Errorf("failed to read resource identifier: %w", err)
+		}
+		return resourcedirectory.NewStringID(str), nil
+	}
+
+	var resources []Resource
+	offset := 2
+	for {
+		if offset+2 > len(data) {
+			return nil, fmt.Errorf("the resource table is not terminated")
+		}
+		typeID := binary.LittleEndian.Uint16(data[offset:])
+		if typeID == 0 {
+			break
+		}
+		if offset+resourceTypeSize > len(data) {
+			return nil, fmt.Errorf("the resource type at offset %d exceeds the bounds of the resource table", offset)
+		}
+		count := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		typ, err := readID(typeID)
+		if err != nil {
+			return nil, err
+		}
+		offset += resourceTypeSize
+
+		if offset+count*resourceNameSize > len(data) {
+			return nil, fmt.Errorf("the resources of type %s exceed the bounds of the resource table", typ)
+		}
+		for range count {
+			entry := data[offset : offset+resourceNameSize]
+			id, err := readID(binary.LittleEndian.Uint16(entry[6:8]))
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, Resource{
+				Type:  typ,
+				ID:    id,
+				Flags: ResourceFlags(binary.LittleEndian.Uint16(entry[4:6])),
+				Location: imagefile.FileRange{
+					Start:  imagefile.FileOffset(uint(binary.LittleEndian.Uint16(entry[0:2])) << shift),
+					Length: uint(binary.LittleEndian.Uint16(entry[2:4])) << shift,
+				},
+			})
+			offset += resourceNameSize
+		}
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,75 @@
+package ne
+
+import (
+	"encoding/binary"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// segmentEntrySize is the size of an entry in the segment table.
+const segmentEntrySize = 8
+
+// SegmentFlags holds the attributes of a segment.
+type SegmentFlags uint16
+
+// Segment flags.
+const (
+	SegmentData        SegmentFlags = 0x0001 // NSDATA, The segment holds data rather than code
+	SegmentMovable     SegmentFlags = 0x0010 // NSMOVE, The segment can be moved in memory
+	SegmentShared      SegmentFlags = 0x0020 // NSSHARED, The segment is shared by all instances
+	SegmentPreload     SegmentFlags = 0x0040 // NSPRELOAD, The segment is loaded when the module is loaded
+	SegmentReadOnly    SegmentFlags = 0x0080 // NSEXRD, The segment is read-only (data) or execute-only (code)
+	SegmentRelocations SegmentFlags = 0x0100 // NSRELOC, The segment data is followed by relocation records
+	SegmentDiscardable SegmentFlags = 0x1000 // NSDISCARD, The segment can be discarded
+)
+
+// IsData returns true if the segment holds data rather than code.
+func (flags SegmentFlags) IsData() bool {
+	return flags&SegmentData != 0
+}
+
+// Segment is an entry in the segment table of an NE file.
+type Segment struct {
+	// Location is the file range of the segment's data. It is zero if the
+	// segment has no data in the file.
+	Location imagefile.FileRange
+
+	// Flags holds the attributes of the segment.
+	Flags SegmentFlags
+
+	// MinAlloc is the number of bytes allocated for the segment in memory.
+	MinAlloc uint32
+}
+
+// segmentEntry is an entry in the segment table.
+type segmentEntry []byte
+
+// Sector returns the logical sector of the segment's data (ns_sector).
+func (entry segmentEntry) Sector() uint16 {
+	return binary.LittleEndian.Uint16(entry[0:2])
+}
+
+// Length returns the size of the segment's data in the file, where zero
+// means 64 KiB (ns_cbseg).
+func (entry segmentEntry) Length() uint32 {
+	return size64K(binary.LittleEndian.Uint16(entry[2:4]))
+}
+
+// Flags returns the segment flags (ns_flags).
+func (entry segmentEntry) Flags() SegmentFlags {
+	return SegmentFlags(binary.LittleEndian.Uint16(entry[4:6]))
+}
+
+// MinAlloc returns the size of the segment in memory, where zero means
+// 64 KiB (ns_minalloc).
+func (entry segmentEntry) MinAlloc() uint32 {
+	return size64K(binary.LittleEndian.Uint16(entry[6:8]))
+}
+
+// size64K interprets a 16-bit size in which zero represents 64 KiB.
+func size64K(size uint16) uint32 {
+	if size == 0 {
+		return 0x10000
+	}
+	return uint32(size)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"io/fs"
 
 	"github.com/gentlemanautomaton/portableexecutable/dos"
 	"github.com/gentlemanautomaton/portableexecutable/imagefile"
@@ -15,12 +16,13 @@ import (
 type Reader struct {
 	source io.ReaderAt
 
-	layout      imagefile.Layout
-	machine     imagefile.Machine
-	format      imagefile.Format
-	subsystem   imagefile.Subsystem
-	sections    SectionTable
-	directories DataDirectoryTable
+	layout         imagefile.Layout
+	machine        imagefile.Machine
+	format         imagefile.Format
+	optionalHeader imagefile.OptionalHeader
+	subsystem      imagefile.Subsystem
+	sections       SectionTable
+	directories    DataDirectoryTable
 }
 
 // NewReader creates and initializes a new portable executable image file
@@ -41,6 +43,26 @@ func (r *Reader) Source() io.ReaderAt {
 	return r.source
 }
 
+// Size returns the size of the underlying source in bytes.
+//
+// The size can only be determined if the source has a Size method, as
+// [bytes.Reader] and [io.SectionReader] do, or a Stat method, as [os.File]
+// does. If it has neither, Size returns an error.
+func (r *Reader) Size() (int64, error) {
+	switch source := r.source.(type) {
+	case interface{ Size() int64 }:
+		return source.Size(), nil
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := source.Stat()
+		if err != nil {
+			return 0, fmt.Errorf("failed to determine the size of the image file: %w", err)
+		}
+		return info.Size(), nil
+	default:
+		return 0, fmt.Errorf("unable to determine the size of the image file because its source does not have a Size or Stat method")
+	}
+}
+
 // Machine returns the machine that the image file is targeting.
 func (r *Reader) Machine() imagefile.Machine {
 	return r.machine
@@ -51,6 +73,11 @@ func (r *Reader) Format() imagefile.Format {
 	return r.format
 }
 
+// OptionalHeader returns the optional header of the image file.
+func (r *Reader) OptionalHeader() imagefile.OptionalHeader {
+	return r.optionalHeader
+}
+
 // Subsystem returns the subsystem that is responsible for executing the
 // image.
 func (r *Reader) Subsystem() imagefile.Subsystem {
@@ -81,6 +108,17 @@ func (r *Reader) ReadRange(fileRange imagefile.FileRange) ([]byte, error) {
 	return data, err
 }
 
+// ReadRichHeader reads and parses the rich header that precedes the PE
+// header, if present. If the image file does not have a rich header it
+// returns [dos.ErrMissingRichHeader].
+func (r *Reader) ReadRichHeader() (dos.RichHeader, error) {
+	data, err := r.ReadRange(imagefile.FileRange{Length: uint(r.layout.Start)})
+	if err != nil {
+		return dos.RichHeader{}, fmt.Errorf("failed to read the DOS stub: %w", err)
+	}
+	return dos.ParseRichHeader(data)
+}
+
 // ReadString returns a string from the image file's string table with the
 // given offset. If the string is longer than 4096 bytes it will be
 // truncated.
@@ -183,6 +221,7 @@ func (r *Reader) init(source io.ReaderAt) error {
 			return fmt.Errorf("the optional header section of the portable executable has an unsupported format: %s", r.format)
 		}
 
+		r.optionalHeader = optionalHeader
 		r.subsystem = optionalHeader.Subsystem()
 		dataDirs = optionalHeader.DataDirectories()
 	}
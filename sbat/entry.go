@@ -0,0 +1,93 @@
+package sbat
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single generation record within the SBAT section of an EFI
+// image file.
+//
+// https://github.com/rhboot/shim/blob/main/SBAT.md
+type Entry struct {
+	Component     string // The name of the component, such as "shim" or "grub"
+	Generation    uint   // The security generation of the component
+	VendorName    string // A human-readable name of the vendor
+	VendorPackage string // The vendor's name for the package
+	VendorVersion string // The vendor's version of the package
+	VendorURL     string // A URL where further information can be found
+}
+
+// String returns a string representation of the entry in its CSV form.
+func (entry Entry) String() string {
+	return strings.Join([]string{
+		entry.Component,
+		strconv.FormatUint(uint64(entry.Generation), 10),
+		entry.VendorName,
+		entry.VendorPackage,
+		entry.VendorVersion,
+		entry.VendorURL,
+	}, ",")
+}
+
+// entryFields is the number of fields in each SBAT section record.
+const entryFields = 6
+
+// Parse parses the contents of an SBAT section and returns its entries.
+//
+// The section holds one comma-separated record per line. Parsing stops at
+// the first null byte, which marks the end of the data within the section.
+func Parse(data []byte) ([]Entry, error) {
+	if cutoff := bytes.IndexByte(data, 0); cutoff >= 0 {
+		data = data[:cutoff]
+	}
+
+	var entries []Entry
+	for i, line := range lines(string(data)) {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) < entryFields {
+			return nil, fmt.Errorf("SBAT record %d has %d field(s) when %d are required: %q", i+1, len(fields), entryFields, line)
+		}
+		generation, err := parseGeneration(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("SBAT record %d for component \"%s\" has an invalid generation: %w", i+1, fields[0], err)
+		}
+		entries = append(entries, Entry{
+			Component:     fields[0],
+			Generation:    generation,
+			VendorName:    fields[2],
+			VendorPackage: fields[3],
+			VendorVersion: fields[4],
+			VendorURL:     fields[5],
+		})
+	}
+	return entries, nil
+}
+
+// lines splits text into lines, removing carriage returns that precede
+// line feeds.
+func lines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+	return lines
+}
+
+// parseGeneration parses a generation number, which must be a positive
+// decimal integer.
+func parseGeneration(value string) (uint, error) {
+	generation, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if generation == 0 {
+		return 0, fmt.Errorf("the generation number must be greater than zero")
+	}
+	return uint(generation), nil
+}
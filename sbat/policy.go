@@ -0,0 +1,106 @@
+package sbat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyEntry specifies the minimum generation of a component that is
+// permitted to run.
+type PolicyEntry struct {
+	Component  string
+	Generation uint
+}
+
+// Policy is an SBAT revocation policy, as stored in the SbatLevel UEFI
+// variable.
+//
+// The first record of the policy is expected to be the "sbat" record,
+// which holds the version of the SBAT format and a date stamp that
+// identifies the revision of the policy. It is followed by one record for
+// each revoked component.
+type Policy struct {
+	DateStamp string
+	Entries   []PolicyEntry
+}
+
+// ParsePolicy parses the text of an SBAT revocation policy, such as the
+// contents of the SbatLevel UEFI variable.
+//
+// Blank lines and lines that start with '#' are ignored.
+func ParsePolicy(text string) (Policy, error) {
+	if cutoff := strings.IndexByte(text, 0); cutoff >= 0 {
+		text = text[:cutoff]
+	}
+
+	var policy Policy
+	for i, line := range lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) < 2 {
+			return Policy{}, fmt.Errorf("SBAT policy record %d has %d field(s) when at least 2 are required: %q", i+1, len(fields), line)
+		}
+		generation, err := parseGeneration(fields[1])
+		if err != nil {
+			return Policy{}, fmt.Errorf("SBAT policy record %d for component \"%s\" has an invalid generation: %w", i+1, fields[0], err)
+		}
+		if len(policy.Entries) == 0 {
+			if fields[0] != "sbat" {
+				return Policy{}, fmt.Errorf("SBAT policy starts with a record for component \"%s\" instead of \"sbat\"", fields[0])
+			}
+			if len(fields) > 2 {
+				policy.DateStamp = fields[2]
+			}
+		}
+		policy.Entries = append(policy.Entries, PolicyEntry{
+			Component:  fields[0],
+			Generation: generation,
+		})
+	}
+
+	if len(policy.Entries) == 0 {
+		return Policy{}, fmt.Errorf("the SBAT policy does not contain any records")
+	}
+
+	return policy, nil
+}
+
+// Revocation describes an SBAT entry that has been revoked by a policy.
+type Revocation struct {
+	Entry   Entry // The entry that was revoked
+	Minimum uint  // The minimum generation required by the policy
+}
+
+// String returns a string representation of the revocation.
+func (revocation Revocation) String() string {
+	return fmt.Sprintf("%s generation %d is less than the minimum generation %d", revocation.Entry.Component, revocation.Entry.Generation, revocation.Minimum)
+}
+
+// Evaluate compares the given SBAT entries with the policy and returns
+// a revocation for each entry with a generation that is lower than the
+// minimum generation required by the policy for its component.
+//
+// Components that are not mentioned by the policy are never revoked.
+func (policy Policy) Evaluate(entries []Entry) []Revocation {
+	var revocations []Revocation
+	for _, rule := range policy.Entries {
+		for _, entry := range entries {
+			if entry.Component == rule.Component && entry.Generation < rule.Generation {
+				revocations = append(revocations, Revocation{
+					Entry:   entry,
+					Minimum: rule.Generation,
+				})
+			}
+		}
+	}
+	return revocations
+}
+
+// Revoked returns true if any of the given SBAT entries are revoked by
+// the policy.
+func (policy Policy) Revoked(entries []Entry) bool {
+	return len(policy.Evaluate(entries)) > 0
+}
@@ -0,0 +1,41 @@
+package sbat
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// SectionName is the name of the section that holds SBAT data.
+const SectionName imagefile.SectionName = ".sbat"
+
+// ErrMissingSection is returned by [Read] if the image file does not have
+// an SBAT section.
+var ErrMissingSection = errors.New("the portable executable does not have an SBAT section")
+
+// Read reads and parses the SBAT section of the image file read by pe.
+// If the image file does not have an SBAT section it returns
+// [ErrMissingSection].
+func Read(pe *portableexecutable.Reader) ([]Entry, error) {
+	for _, section := range pe.Sections() {
+		if section.Name != SectionName {
+			continue
+		}
+
+		// The raw data of the section is padded to the file alignment, so
+		// limit it to the virtual size when possible.
+		location := section.FileRange
+		if size := section.RelativeVirtualAddressRange.Length; size > 0 && size < location.Length {
+			location.Length = size
+		}
+
+		data, err := pe.ReadRange(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the SBAT section: %w", err)
+		}
+		return Parse(data)
+	}
+	return nil, ErrMissingSection
+}
@@ -0,0 +1,121 @@
+package certificatetable
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Revision identifies the version of the WIN_CERTIFICATE structure used by
+// a certificate table entry.
+type Revision uint16
+
+// Known certificate entry revisions.
+const (
+	Revision1 Revision = 0x0100 // WIN_CERT_REVISION_1_0, Legacy version of the WIN_CERTIFICATE structure
+	Revision2 Revision = 0x0200 // WIN_CERT_REVISION_2_0, Current version of the WIN_CERTIFICATE structure
+)
+
+// String returns a string representation of the revision.
+func (revision Revision) String() string {
+	switch revision {
+	case Revision1:
+		return "1.0"
+	case Revision2:
+		return "2.0"
+	default:
+		return fmt.Sprintf("<unrecognized certificate revision: %x>", uint16(revision))
+	}
+}
+
+// Type identifies the type of content held by a certificate table entry.
+type Type uint16
+
+// Known certificate entry types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#the-attribute-certificate-table-image-only
+const (
+	TypeX509           Type = 0x0001 // WIN_CERT_TYPE_X509, An X.509 certificate (not supported)
+	TypePKCSSignedData Type = 0x0002 // WIN_CERT_TYPE_PKCS_SIGNED_DATA, A PKCS#7 SignedData structure
+	TypeReserved1      Type = 0x0003 // WIN_CERT_TYPE_RESERVED_1, Reserved
+	TypeTSStackSigned  Type = 0x0004 // WIN_CERT_TYPE_TS_STACK_SIGNED, Terminal Server Protocol Stack Certificate signing (not supported)
+)
+
+// String returns a string representation of the certificate type.
+func (t Type) String() string {
+	switch t {
+	case TypeX509:
+		return "X.509"
+	case TypePKCSSignedData:
+		return "PKCS Signed Data"
+	case TypeReserved1:
+		return "Reserved"
+	case TypeTSStackSigned:
+		return "TS Stack Signed"
+	default:
+		return fmt.Sprintf("<unrecognized certificate type: %x>", uint16(t))
+	}
+}
+
+// HeaderSize is the size of the fixed WIN_CERTIFICATE header that precedes
+// the content of each certificate table entry.
+const HeaderSize = 8
+
+// Alignment is the alignment of certificate table entries within the
+// certificate table. Each entry starts on an 8 byte (quadword) boundary.
+const Alignment = 8
+
+// Entry describes a single WIN_CERTIFICATE entry within the certificate
+// table.
+type Entry struct {
+	// Location is the file range covered by the entry, as declared by its
+	// dwLength field. It includes the entry header.
+	Location imagefile.FileRange
+
+	Revision Revision
+	Type     Type
+}
+
+// Content returns the file range of the entry's content, which excludes
+// the entry header.
+func (entry Entry) Content() imagefile.FileRange {
+	if entry.Location.Length < HeaderSize {
+		return imagefile.FileRange{Start: entry.Location.Start + HeaderSize}
+	}
+	return imagefile.FileRange{
+		Start:  entry.Location.Start + HeaderSize,
+		Length: entry.Location.Length - HeaderSize,
+	}
+}
+
+// Padded returns the file range covered by the entry including any padding
+// that follows it to bring the next entry into alignment.
+func (entry Entry) Padded() imagefile.FileRange {
+	return imagefile.FileRange{
+		Start:  entry.Location.Start,
+		Length: Align(entry.Location.Length),
+	}
+}
+
+// Align returns length rounded up to the next multiple of [Alignment].
+func Align(length uint) uint {
+	if remainder := length % Alignment; remainder != 0 {
+		return length + Alignment - remainder
+	}
+	return length
+}
+
+type entryHeader []byte
+
+func (header entryHeader) Length() uint32 {
+	return binary.LittleEndian.Uint32(header[0:4])
+}
+
+func (header entryHeader) Revision() Revision {
+	return Revision(binary.LittleEndian.Uint16(header[4:6]))
+}
+
+func (header entryHeader) Type() Type {
+	return Type(binary.LittleEndian.Uint16(header[6:8]))
+}
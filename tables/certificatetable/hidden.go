@@ -0,0 +1,170 @@
+package certificatetable
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// HiddenDataKind identifies where hidden data was found in or around the
+// certificate table.
+type HiddenDataKind int
+
+// Kinds of hidden data.
+const (
+	// TrailingData is data that follows the end of the certificate table.
+	// The certificate table is expected to be the last thing in the file.
+	TrailingData HiddenDataKind = iota + 1
+
+	// UnusedTableData is data inside the certificate table that follows its
+	// last entry.
+	UnusedTableData
+
+	// OversizedEntry is data inside a certificate table entry that follows
+	// the end of its PKCS#7 content, beyond what is needed for alignment.
+	// It is included in the entry's dwLength field.
+	OversizedEntry
+
+	// NonZeroPadding is alignment padding that contains non-zero bytes.
+	NonZeroPadding
+)
+
+// String returns a string representation of the hidden data kind.
+func (kind HiddenDataKind) String() string {
+	switch kind {
+	case TrailingData:
+		return "Trailing Data"
+	case UnusedTableData:
+		return "Unused Table Data"
+	case OversizedEntry:
+		return "Oversized Entry"
+	case NonZeroPadding:
+		return "Non-Zero Padding"
+	default:
+		return fmt.Sprintf("<unrecognized hidden data kind: %d>", int(kind))
+	}
+}
+
+// HiddenData describes a range of bytes in or around the certificate table
+// that isn't covered by the content of any certificate.
+//
+// These bytes are excluded from the Authenticode hash, so data stored in
+// them can be altered without invalidating the image's signatures.
+type HiddenData struct {
+	Kind     HiddenDataKind
+	Location imagefile.FileRange
+}
+
+// String returns a string representation of the hidden data.
+func (hidden HiddenData) String() string {
+	return fmt.Sprintf("%s at %s", hidden.Kind, hidden.Location)
+}
+
+// FindHiddenData examines the certificate table and the end of the image
+// file for data that isn't part of any certificate. It reports:
+//
+//   - Data that follows the end of the certificate table
+//   - Data in the certificate table that follows its last entry
+//   - PKCS#7 entries with a dwLength that exceeds their content by more
+//     than the alignment requires
+//   - Alignment padding that contains non-zero bytes
+//
+// The size of the image must be available from the underlying
+// [portableexecutable.Reader]. See [portableexecutable.Reader.Size] for
+// details.
+func (r *Reader) FindHiddenData() ([]HiddenData, error) {
+	size, err := r.pe.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := r.ReadTable()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []HiddenData
+
+	for _, entry := range table {
+		// Look for data inside the entry that follows its PKCS#7 content.
+		// Content that isn't valid DER is left for signature parsing to
+		// report.
+		if entry.Type == TypePKCSSignedData {
+			content, err := r.ReadContent(entry)
+			if err != nil {
+				return nil, err
+			}
+			if rest, err := asn1.Unmarshal(content, &asn1.RawValue{}); err == nil && len(rest) > 0 {
+				slack := imagefile.FileRange{
+					Start:  end(entry.Location) - imagefile.FileOffset(len(rest)),
+					Length: uint(len(rest)),
+				}
+				switch {
+				case slack.Length >= Alignment:
+					found = append(found, HiddenData{Kind: OversizedEntry, Location: slack})
+				case !isZero(rest):
+					found = append(found, HiddenData{Kind: NonZeroPadding, Location: slack})
+				}
+			}
+		}
+
+		// Look for non-zero bytes in the padding that follows the entry.
+		padding := imagefile.FileRange{
+			Start:  end(entry.Location),
+			Length: Align(entry.Location.Length) - entry.Location.Length,
+		}
+		if limit := end(r.location); end(padding) > limit {
+			padding.Length = uint(limit - padding.Start)
+		}
+		if padding.Length > 0 {
+			data, err := r.pe.ReadRange(padding)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read certificate table entry padding at location %s: %w", padding, err)
+			}
+			if !isZero(data) {
+				found = append(found, HiddenData{Kind: NonZeroPadding, Location: padding})
+			}
+		}
+	}
+
+	// Look for unused space at the end of the table.
+	var used uint
+	if len(table) > 0 {
+		last := table[len(table)-1]
+		used = uint(end(last.Padded()) - r.location.Start)
+	}
+	if used < r.location.Length {
+		found = append(found, HiddenData{
+			Kind: UnusedTableData,
+			Location: imagefile.FileRange{
+				Start:  r.location.Start + imagefile.FileOffset(used),
+				Length: r.location.Length - used,
+			},
+		})
+	}
+
+	// Look for data that follows the table.
+	if limit := end(r.location); int64(limit) < size {
+		found = append(found, HiddenData{
+			Kind: TrailingData,
+			Location: imagefile.FileRange{
+				Start:  limit,
+				Length: uint(size - int64(limit)),
+			},
+		})
+	}
+
+	return found, nil
+}
+
+// end returns the offset immediately following the given file range.
+func end(location imagefile.FileRange) imagefile.FileOffset {
+	return location.Start + imagefile.FileOffset(location.Length)
+}
+
+// isZero returns true if all of the bytes in data are zero.
+func isZero(data []byte) bool {
+	return len(bytes.Trim(data, "\x00")) == 0
+}
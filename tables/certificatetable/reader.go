@@ -0,0 +1,89 @@
+package certificatetable
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+var (
+	// ErrMissingCertificateTable is returned by [NewReader] if it is asked to
+	// operate on a portable executable that doesn't have a certificate table.
+	ErrMissingCertificateTable = errors.New("the portable executable does not have a certificate table")
+)
+
+// Reader reads attribute certificate table data for a portable executable
+// image file from an underlying [portableexecutable.Reader].
+//
+// Unlike most data directories, the certificate table is not mapped into
+// memory when the image is loaded. Its location is expressed as a file
+// offset rather than a relative virtual address.
+type Reader struct {
+	pe       *portableexecutable.Reader
+	location imagefile.FileRange
+}
+
+// NewReader creates and initializes a new certificate table [Reader] that
+// reads from portable executable [portableexecutable.Reader] pe. It returns
+// [ErrMissingCertificateTable] if the portable executable does not have a
+// certificate table.
+func NewReader(pe *portableexecutable.Reader) (*Reader, error) {
+	certificates := pe.DataDirectories().Get(imagefile.CertificateTableID)
+	if certificates.IsZero() {
+		return nil, ErrMissingCertificateTable
+	}
+
+	return &Reader{
+		pe:       pe,
+		location: certificates.Location,
+	}, nil
+}
+
+// Location returns the file range of the certificate table, as declared by
+// the data directory.
+func (r *Reader) Location() imagefile.FileRange {
+	return r.location
+}
+
+// ReadTable reads the headers of all entries within the certificate table
+// and returns them.
+func (r *Reader) ReadTable() (Table, error) {
+	var (
+		table  Table
+		offset uint
+	)
+	for offset+HeaderSize <= r.location.Length {
+		start := r.location.Start + imagefile.FileOffset(offset)
+		data, err := r.pe.ReadRange(imagefile.FileRange{Start: start, Length: HeaderSize})
+		if err != nil {
+			return table, fmt.Errorf("failed to read certificate table entry header at offset %s: %w", start, err)
+		}
+		header := entryHeader(data)
+		length := uint(header.Length())
+		if length < HeaderSize {
+			return table, fmt.Errorf("the certificate table entry at offset %s has an invalid length of %d byte(s)", start, length)
+		}
+		if offset+length > r.location.Length {
+			return table, fmt.Errorf("the certificate table entry at offset %s has a length of %d bytes, which exceeds the bounds of the certificate table", start, length)
+		}
+		table = append(table, Entry{
+			Location: imagefile.FileRange{Start: start, Length: length},
+			Revision: header.Revision(),
+			Type:     header.Type(),
+		})
+		offset += Align(length)
+	}
+	return table, nil
+}
+
+// ReadContent returns the content of the given certificate table entry,
+// excluding the entry header.
+func (r *Reader) ReadContent(entry Entry) ([]byte, error) {
+	data, err := r.pe.ReadRange(entry.Content())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate table entry content at location %s: %w", entry.Content(), err)
+	}
+	return data, nil
+}
@@ -0,0 +1,4 @@
+package certificatetable
+
+// Table holds the set of entries within an attribute certificate table.
+type Table []Entry
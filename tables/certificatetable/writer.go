@@ -0,0 +1,195 @@
+package certificatetable
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Certificate holds the content of a certificate table entry that is to be
+// written to an image file.
+type Certificate struct {
+	Revision Revision
+	Type     Type
+	Data     []byte
+}
+
+// WriteImage writes a copy of the image file read by pe to w, with its
+// certificate table replaced by a table holding the given certificates.
+//
+// The image data that precedes the existing certificate table is copied
+// unaltered, except for the certificate table data directory entry and the
+// image checksum, which are rewritten. If the image does not have a
+// certificate table, all of its data is copied. Any data that follows an
+// existing certificate table is discarded.
+//
+// The new table is placed at the end of the image, which is padded with
+// zeroes so that the table starts on an 8 byte boundary. Each entry within
+// the table is padded in the same way.
+//
+// If no certificates are provided, the image is written without a
+// certificate table and its data directory entry is zeroed.
+//
+// The size of the image must be available from pe. See
+// [portableexecutable.Reader.Size] for details.
+func WriteImage(w io.Writer, pe *portableexecutable.Reader, certificates ...Certificate) error {
+	size, err := pe.Size()
+	if err != nil {
+		return err
+	}
+
+	// Determine where the image data ends. If there's an existing table,
+	// everything from its start onward will be replaced.
+	end := imagefile.FileOffset(size)
+	if existing := pe.DataDirectories().Get(imagefile.CertificateTableID); !existing.IsZero() && existing.Location.Start < end {
+		end = existing.Location.Start
+	}
+
+	layout := pe.Layout()
+	entryRange := layout.DataDirectoryEntry(pe.Format(), imagefile.CertificateTableID)
+	if entryRange.IsZero() && len(certificates) > 0 {
+		return fmt.Errorf("the optional header of the portable executable does not have room for a certificate table data directory entry")
+	}
+
+	// Prepare the new table.
+	var table []byte
+	for i, cert := range certificates {
+		length := HeaderSize + uint(len(cert.Data))
+		if length > 0xFFFFFFFF {
+			return fmt.Errorf("certificate %d has a length of %d bytes, which exceeds the maximum size of a certificate table entry", i, length)
+		}
+		var header [HeaderSize]byte
+		binary.LittleEndian.PutUint32(header[0:4], uint32(length))
+		binary.LittleEndian.PutUint16(header[4:6], uint16(cert.Revision))
+		binary.LittleEndian.PutUint16(header[6:8], uint16(cert.Type))
+		table = append(table, header[:]...)
+		table = append(table, cert.Data...)
+		table = append(table, make([]byte, Align(length)-length)...)
+	}
+
+	start := end
+	if len(table) > 0 {
+		start = imagefile.FileOffset(Align(uint(end)))
+	}
+
+	// Read the headers up to the end of the data directory entry, then
+	// update the entry to describe the new table.
+	headerLength := uint(layout.CheckSum().Start) + imagefile.CheckSumSize
+	if !entryRange.IsZero() {
+		headerLength = max(headerLength, uint(entryRange.Start)+entryRange.Length)
+	}
+	if headerLength > uint(end) {
+		return fmt.Errorf("the portable executable headers extend beyond the end of its image data")
+	}
+	header, err := pe.ReadRange(imagefile.FileRange{Length: headerLength})
+	if err != nil {
+		return fmt.Errorf("failed to read the portable executable headers: %w", err)
+	}
+	if !entryRange.IsZero() {
+		entry := header[entryRange.Start:]
+		if len(table) > 0 {
+			binary.LittleEndian.PutUint32(entry[0:4], uint32(start))
+			binary.LittleEndian.PutUint32(entry[4:8], uint32(len(table)))
+		} else {
+			binary.LittleEndian.PutUint64(entry[0:8], 0)
+		}
+	}
+
+	img := image{
+		header:  header,
+		source:  pe.Source(),
+		end:     end,
+		padding: uint(start - end),
+		table:   table,
+	}
+
+	// Compute the checksum of the new image, then write it into the header.
+	checksumRange := layout.CheckSum()
+	checksum := imagefile.NewCheckSumWriter(checksumRange)
+	if err := img.write(checksum); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(header[checksumRange.Start:], checksum.Sum())
+
+	return img.write(w)
+}
+
+// Append writes a copy of the image file read by pe to w, with the given
+// certificates appended to the end of its existing certificate table. If
+// the image does not have a certificate table, one is created.
+//
+// The certificate table data directory entry and the image checksum are
+// rewritten. See [WriteImage] for details.
+func Append(w io.Writer, pe *portableexecutable.Reader, certificates ...Certificate) error {
+	existing, err := ReadCertificates(pe)
+	if err != nil && err != ErrMissingCertificateTable {
+		return err
+	}
+	return WriteImage(w, pe, append(existing, certificates...)...)
+}
+
+// Remove writes a copy of the image file read by pe to w, without its
+// certificate table. The certificate table data directory entry is zeroed
+// and the image checksum is rewritten. See [WriteImage] for details.
+func Remove(w io.Writer, pe *portableexecutable.Reader) error {
+	return WriteImage(w, pe)
+}
+
+// ReadCertificates reads all of the entries in the certificate table of the
+// image file read by pe, along with their content.
+//
+// If the image does not have a certificate table, it returns
+// [ErrMissingCertificateTable].
+func ReadCertificates(pe *portableexecutable.Reader) ([]Certificate, error) {
+	reader, err := NewReader(pe)
+	if err != nil {
+		return nil, err
+	}
+	table, err := reader.ReadTable()
+	if err != nil {
+		return nil, err
+	}
+	certificates := make([]Certificate, 0, len(table))
+	for _, entry := range table {
+		data, err := reader.ReadContent(entry)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, Certificate{
+			Revision: entry.Revision,
+			Type:     entry.Type,
+			Data:     data,
+		})
+	}
+	return certificates, nil
+}
+
+// image describes the data of an image file that is being written.
+type image struct {
+	header  []byte
+	source  io.ReaderAt
+	end     imagefile.FileOffset
+	padding uint
+	table   []byte
+}
+
+// write writes the image to w.
+func (img image) write(w io.Writer) error {
+	if _, err := w.Write(img.header); err != nil {
+		return err
+	}
+	remaining := io.NewSectionReader(img.source, int64(len(img.header)), int64(img.end)-int64(len(img.header)))
+	if _, err := io.Copy(w, remaining); err != nil {
+		return fmt.Errorf("failed to copy portable executable image data: %w", err)
+	}
+	if _, err := w.Write(make([]byte, img.padding)); err != nil {
+		return err
+	}
+	if _, err := w.Write(img.table); err != nil {
+		return err
+	}
+	return nil
+}
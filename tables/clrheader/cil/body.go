@@ -0,0 +1,241 @@
+package cil
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+)
+
+// Method header formats and flags.
+//
+// ECMA-335 II.25.4.1
+const (
+	headerFormatMask = 0x03
+	headerTiny       = 0x02 // CorILMethod_TinyFormat
+	headerFat        = 0x03 // CorILMethod_FatFormat
+	headerMoreSects  = 0x08 // CorILMethod_MoreSects
+	headerInitLocals = 0x10 // CorILMethod_InitLocals
+	tinyMaxStack     = 8
+	fatHeaderSize    = 12
+)
+
+// Method data section flags.
+//
+// ECMA-335 II.25.4.5
+const (
+	sectionEHTable   = 0x01 // CorILMethod_Sect_EHTable
+	sectionFatFormat = 0x40 // CorILMethod_Sect_FatFormat
+	sectionMoreSects = 0x80 // CorILMethod_Sect_MoreSects
+	smallClauseSize  = 12
+	fatClauseSize    = 24
+)
+
+// MethodBody is the body of a managed method, which holds its CIL code.
+type MethodBody struct {
+	// Tiny is true if the method has a tiny header, which implies that it
+	// has no local variables or exception clauses, and a maximum stack
+	// depth of 8.
+	Tiny bool
+
+	// InitLocals is true if the local variables are initialized to zero.
+	InitLocals bool
+
+	// MaxStack is the maximum number of items on the evaluation stack.
+	MaxStack uint16
+
+	// LocalVarSig is the StandAloneSig token of the signature of the local
+	// variables, or a nil token if the method has no local variables.
+	LocalVarSig metadata.Token
+
+	// Code holds the CIL instructions of the method, which can be decoded
+	// with [Decode].
+	Code []byte
+
+	ExceptionClauses []ExceptionClause
+}
+
+// ExceptionClauseKind identifies the kind of an exception handling
+// clause.
+type ExceptionClauseKind uint32
+
+// Exception handling clause kinds.
+//
+// ECMA-335 II.25.4.6
+const (
+	ClauseCatch   ExceptionClauseKind = 0x0000 // COR_ILEXCEPTION_CLAUSE_EXCEPTION
+	ClauseFilter  ExceptionClauseKind = 0x0001 // COR_ILEXCEPTION_CLAUSE_FILTER
+	ClauseFinally ExceptionClauseKind = 0x0002 // COR_ILEXCEPTION_CLAUSE_FINALLY
+	ClauseFault   ExceptionClauseKind = 0x0004 // COR_ILEXCEPTION_CLAUSE_FAULT
+)
+
+// String returns a string representation of the clause kind.
+func (kind ExceptionClauseKind) String() string {
+	switch kind {
+	case ClauseCatch:
+		return "catch"
+	case ClauseFilter:
+		return "filter"
+	case ClauseFinally:
+		return "finally"
+	case ClauseFault:
+		return "fault"
+	default:
+		return fmt.Sprintf("<unrecognized exception clause kind: %x>", uint32(kind))
+	}
+}
+
+// ExceptionClause describes a protected region of code and the handler
+// that is executed when an exception occurs within it. Offsets are
+// relative to the start of the method's code.
+type ExceptionClause struct {
+	Kind          ExceptionClauseKind
+	TryOffset     uint32
+	TryLength     uint32
+	HandlerOffset uint32
+	HandlerLength uint32
+
+	// CatchType is the type of exception that is caught by a catch
+	// clause.
+	CatchType metadata.Token
+
+	// FilterOffset is the offset of the filter code of a filter clause.
+	FilterOffset uint32
+}
+
+// ParseMethodBody parses the method body at the start of data.
+func ParseMethodBody(data []byte) (MethodBody, error) {
+	return parseMethodBody(func(offset, length uint32) ([]byte, error) {
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return nil, fmt.Errorf("the method body is truncated")
+		}
+		return data[offset : offset+length], nil
+	})
+}
+
+// ReadMethodBody reads the method body at the given relative virtual
+// address, which is recorded by the MethodDef metadata table.
+func ReadMethodBody(pe *portableexecutable.Reader, address imagefile.RelativeVirtualAddress) (MethodBody, error) {
+	body, err := parseMethodBody(func(offset, length uint32) ([]byte, error) {
+		addressRange := imagefile.RelativeVirtualAddressRange{
+			Start:  address + imagefile.RelativeVirtualAddress(offset),
+			Length: uint(length),
+		}
+		ok, location := pe.Sections().TranslateRange(addressRange)
+		if !ok {
+			return nil, fmt.Errorf("the virtual address range (%s) is not mapped to any section within the image file", addressRange)
+		}
+		return pe.ReadRange(location)
+	})
+	if err != nil {
+		return MethodBody{}, fmt.Errorf("failed to read the method body at %s: %w", address, err)
+	}
+	return body, nil
+}
+
+// parseMethodBody parses a method body, using read to retrieve the bytes
+// at an offset from its start.
+func parseMethodBody(read func(offset, length uint32) ([]byte, error)) (MethodBody, error) {
+	first, err := read(0, 1)
+	if err != nil {
+		return MethodBody{}, err
+	}
+
+	var body MethodBody
+	var codeOffset, codeSize uint32
+	var flags uint16
+	switch first[0] & headerFormatMask {
+	case headerTiny:
+		body.Tiny = true
+		body.MaxStack = tinyMaxStack
+		codeOffset, codeSize = 1, uint32(first[0]>>2)
+	case headerFat:
+		header, err := read(0, fatHeaderSize)
+		if err != nil {
+			return MethodBody{}, err
+		}
+		flags = binary.LittleEndian.Uint16(header[0:2])
+		size := uint32(flags>>12) * 4
+		if size < fatHeaderSize {
+			return MethodBody{}, fmt.Errorf("the method body has a fat header with an invalid size of %d bytes", size)
+		}
+		body.InitLocals = flags&headerInitLocals != 0
+		body.MaxStack = binary.LittleEndian.Uint16(header[2:4])
+		body.LocalVarSig = metadata.Token(binary.LittleEndian.Uint32(header[8:12]))
+		codeOffset, codeSize = size, binary.LittleEndian.Uint32(header[4:8])
+	default:
+		return MethodBody{}, fmt.Errorf("the method body has an unrecognized header format of 0x%x", first[0]&headerFormatMask)
+	}
+
+	if body.Code, err = read(codeOffset, codeSize); err != nil {
+		return MethodBody{}, err
+	}
+
+	// Data sections follow the code, aligned to 4 bytes.
+	more := flags&headerMoreSects != 0
+	offset := uint64(codeOffset) + uint64(codeSize)
+	for more {
+		offset = (offset + 3) &^ 3
+		if offset > uint64(^uint32(0)-4) {
+			return MethodBody{}, fmt.Errorf("the method body has a data section at an invalid offset")
+		}
+		header, err := read(uint32(offset), 4)
+		if err != nil {
+			return MethodBody{}, err
+		}
+		kind := header[0]
+		more = kind&sectionMoreSects != 0
+
+		var size, clauseSize uint32
+		if kind&sectionFatFormat != 0 {
+			size, clauseSize = uint32(header[1])|uint32(header[2])<<8|uint32(header[3])<<16, fatClauseSize
+		} else {
+			size, clauseSize = uint32(header[1]), smallClauseSize
+		}
+		if size < 4 {
+			return MethodBody{}, fmt.Errorf("the method body has a data section with an invalid size of %d bytes", size)
+		}
+		if kind&sectionEHTable != 0 {
+			data, err := read(uint32(offset)+4, (size-4)/clauseSize*clauseSize)
+			if err != nil {
+				return MethodBody{}, err
+			}
+			for i := 0; i < len(data); i += int(clauseSize) {
+				body.ExceptionClauses = append(body.ExceptionClauses, parseClause(data[i:i+int(clauseSize)]))
+			}
+		}
+		offset += uint64(size)
+	}
+
+	return body, nil
+}
+
+// parseClause parses a small or fat exception handling clause.
+func parseClause(data []byte) ExceptionClause {
+	var clause ExceptionClause
+	var extra uint32
+	if len(data) == fatClauseSize {
+		clause.Kind = ExceptionClauseKind(binary.LittleEndian.Uint32(data[0:4]))
+		clause.TryOffset = binary.LittleEndian.Uint32(data[4:8])
+		clause.TryLength = binary.LittleEndian.Uint32(data[8:12])
+		clause.HandlerOffset = binary.LittleEndian.Uint32(data[12:16])
+		clause.HandlerLength = binary.LittleEndian.Uint32(data[16:20])
+		extra = binary.LittleEndian.Uint32(data[20:24])
+	} else {
+		clause.Kind = ExceptionClauseKind(binary.LittleEndian.Uint16(data[0:2]))
+		clause.TryOffset = uint32(binary.LittleEndian.Uint16(data[2:4]))
+		clause.TryLength = uint32(data[4])
+		clause.HandlerOffset = uint32(binary.LittleEndian.Uint16(data[5:7]))
+		clause.HandlerLength = uint32(data[7])
+		extra = binary.LittleEndian.Uint32(data[8:12])
+	}
+	switch clause.Kind {
+	case ClauseCatch:
+		clause.CatchType = metadata.Token(extra)
+	case ClauseFilter:
+		clause.FilterOffset = extra
+	}
+	return clause
+}
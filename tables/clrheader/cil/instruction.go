@@ -0,0 +1,182 @@
+package cil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+)
+
+// Instruction is a CIL instruction.
+type Instruction struct {
+	// Offset is the offset of the instruction from the start of the
+	// method's code.
+	Offset int
+
+	OpCode OpCode
+
+	// Operand holds the operand that follows the opcode, according to
+	// its kind:
+	//
+	//   - nil for OperandNone
+	//   - int8, int32, int64, float32 or float64 for immediate values
+	//   - uint16 for argument and local variable indices
+	//   - int for the offset of the target of a branch
+	//   - []int for the offsets of the targets of a switch
+	//   - metadata.Token for tokens
+	Operand any
+}
+
+// Decode decodes the CIL instructions in code.
+func Decode(code []byte) ([]Instruction, error) {
+	var instructions []Instruction
+	for pos := 0; pos < len(code); {
+		inst := Instruction{Offset: pos}
+
+		op := OpCode(code[pos])
+		if op == twoBytePrefix {
+			if pos+1 >= len(code) {
+				return nil, fmt.Errorf("the instruction at offset 0x%x is truncated", inst.Offset)
+			}
+			op = op<<8 | OpCode(code[pos+1])
+		}
+		if !op.Valid() {
+			return nil, fmt.Errorf("the instruction at offset 0x%x has an unrecognized opcode of 0x%x", inst.Offset, uint16(op))
+		}
+		inst.OpCode = op
+		pos += op.Size()
+
+		kind := op.Operand()
+		size := kind.Size()
+		if kind == OperandSwitch {
+			if pos+4 > len(code) {
+				return nil, fmt.Errorf("the instruction at offset 0x%x is truncated", inst.Offset)
+			}
+			count := binary.LittleEndian.Uint32(code[pos:])
+			if uint64(count) > uint64(len(code)-pos-4)/4 {
+				return nil, fmt.Errorf("the switch at offset 0x%x has %d targets that exceed the length of the code", inst.Offset, count)
+			}
+			size = 4 + int(count)*4
+		}
+		if pos+size > len(code) {
+			return nil, fmt.Errorf("the instruction at offset 0x%x is truncated", inst.Offset)
+		}
+		operand := code[pos : pos+size]
+		pos += size
+
+		switch kind {
+		case OperandShortBranch:
+			inst.Operand = pos + int(int8(operand[0]))
+		case OperandBranch:
+			inst.Operand = pos + int(int32(binary.LittleEndian.Uint32(operand)))
+		case OperandInt8:
+			inst.Operand = int8(operand[0])
+		case OperandInt32:
+			inst.Operand = int32(binary.LittleEndian.Uint32(operand))
+		case OperandInt64:
+			inst.Operand = int64(binary.LittleEndian.Uint64(operand))
+		case OperandFloat32:
+			inst.Operand = math.Float32frombits(binary.LittleEndian.Uint32(operand))
+		case OperandFloat64:
+			inst.Operand = math.Float64frombits(binary.LittleEndian.Uint64(operand))
+		case OperandShortVar:
+			inst.Operand = uint16(operand[0])
+		case OperandVar:
+			inst.Operand = binary.LittleEndian.Uint16(operand)
+		case OperandSwitch:
+			// The targets are relative to the end of the instruction.
+			targets := make([]int, (len(operand)-4)/4)
+			for i := range targets {
+				targets[i] = pos + int(int32(binary.LittleEndian.Uint32(operand[4+i*4:])))
+			}
+			inst.Operand = targets
+		default:
+			if kind.IsToken() {
+				inst.Operand = metadata.Token(binary.LittleEndian.Uint32(operand))
+			}
+		}
+
+		instructions = append(instructions, inst)
+	}
+	return instructions, nil
+}
+
+// Token returns the metadata token held by the instruction's operand. It
+// returns false if the operand is not a token.
+func (inst Instruction) Token() (metadata.Token, bool) {
+	token, ok := inst.Operand.(metadata.Token)
+	return token, ok
+}
+
+// String returns a string representation of the instruction, in which
+// tokens are not resolved.
+func (inst Instruction) String() string {
+	return inst.Format(nil)
+}
+
+// Format returns a string representation of the instruction in the style
+// of IL disassemblers, such as "IL_0001: call System.Console::WriteLine".
+// Tokens are resolved to names and strings with md, if it is not nil.
+func (inst Instruction) Format(md *metadata.Reader) string {
+	text := fmt.Sprintf("%s: %s", label(inst.Offset), inst.OpCode)
+	switch operand := inst.Operand.(type) {
+	case nil:
+		return text
+	case int:
+		return text + " " + label(operand)
+	case []int:
+		labels := make([]string, len(operand))
+		for i := range operand {
+			labels[i] = label(operand[i])
+		}
+		return text + " (" + strings.Join(labels, ", ") + ")"
+	case metadata.Token:
+		return text + " " + formatToken(operand, md)
+	default:
+		return fmt.Sprintf("%s %v", text, operand)
+	}
+}
+
+// label returns the label of the instruction at the given offset.
+func label(offset int) string {
+	if offset < 0 {
+		return fmt.Sprintf("IL_-%04x", -offset)
+	}
+	return fmt.Sprintf("IL_%04x", offset)
+}
+
+// formatToken returns a string representation of the given token,
+// resolving it with md if it is not nil.
+func formatToken(token metadata.Token, md *metadata.Reader) string {
+	if md == nil {
+		return token.String()
+	}
+	switch token.Table() {
+	case userStringTable:
+		if s, err := md.UserString(token.Row()); err == nil {
+			return strconv.Quote(s)
+		}
+	case metadata.TableStandAloneSig:
+		sig, err := md.StandAloneSig(token.Row())
+		if err != nil {
+			break
+		}
+		method, err := metadata.ParseMethodSignature(sig.Signature)
+		if err != nil {
+			break
+		}
+		return md.FormatType(metadata.SignatureType{Element: metadata.ElementFnPtr, Method: &method})
+	default:
+		if name, err := md.DisplayName(token); err == nil {
+			return name
+		}
+	}
+	return token.String()
+}
+
+// userStringTable is the table of tokens that refer to strings within the
+// user string heap, which are the operands of ldstr instructions.
+const userStringTable metadata.TableID = 0x70
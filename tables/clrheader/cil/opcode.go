@@ -0,0 +1,110 @@
+package cil
+
+import "fmt"
+
+// OpCode identifies a CIL instruction. The opcodes of instructions that
+// are encoded as two bytes have a high byte of 0xFE.
+type OpCode uint16
+
+// twoBytePrefix is the first byte of instructions that are encoded as two
+// bytes.
+const twoBytePrefix = 0xFE
+
+// opcodeInfo describes an opcode.
+type opcodeInfo struct {
+	name    string
+	operand OperandKind
+}
+
+// info returns a description of the opcode. It returns false if the
+// opcode is not recognized.
+func (op OpCode) info() (opcodeInfo, bool) {
+	var info opcodeInfo
+	switch op >> 8 {
+	case 0:
+		info = oneByteOpcodes[op]
+	case twoBytePrefix:
+		info = twoByteOpcodes[op&0xFF]
+	}
+	return info, info.name != ""
+}
+
+// Valid returns true if the opcode is recognized.
+func (op OpCode) Valid() bool {
+	_, ok := op.info()
+	return ok
+}
+
+// Size returns the number of bytes that encode the opcode.
+func (op OpCode) Size() int {
+	if op>>8 == twoBytePrefix {
+		return 2
+	}
+	return 1
+}
+
+// Operand returns the kind of operand that follows the opcode.
+func (op OpCode) Operand() OperandKind {
+	info, _ := op.info()
+	return info.operand
+}
+
+// String returns the name of the opcode, such as "ldarg.0".
+func (op OpCode) String() string {
+	if info, ok := op.info(); ok {
+		return info.name
+	}
+	return fmt.Sprintf("<unrecognized CIL opcode: %x>", uint16(op))
+}
+
+// OperandKind identifies the kind of operand that follows an opcode.
+type OperandKind uint8
+
+// CIL operand kinds.
+const (
+	OperandNone        OperandKind = iota // No operand
+	OperandShortBranch                    // An 8-bit signed branch offset
+	OperandBranch                         // A 32-bit signed branch offset
+	OperandInt8                           // An 8-bit signed integer
+	OperandInt32                          // A 32-bit signed integer
+	OperandInt64                          // A 64-bit signed integer
+	OperandFloat32                        // A 32-bit floating point number
+	OperandFloat64                        // A 64-bit floating point number
+	OperandShortVar                       // An 8-bit argument or local variable index
+	OperandVar                            // A 16-bit argument or local variable index
+	OperandSwitch                         // A table of 32-bit signed branch offsets
+	OperandField                          // A field token
+	OperandMethod                         // A method token
+	OperandType                           // A type token
+	OperandToken                          // A type, method or field token
+	OperandString                         // A user string token
+	OperandSignature                      // A stand-alone signature token
+)
+
+// Size returns the number of bytes that encode the operand. It returns
+// zero for a switch table, which has a variable size.
+func (kind OperandKind) Size() int {
+	switch kind {
+	case OperandShortBranch, OperandInt8, OperandShortVar:
+		return 1
+	case OperandVar:
+		return 2
+	case OperandBranch, OperandInt32, OperandFloat32, OperandField,
+		OperandMethod, OperandType, OperandToken, OperandString, OperandSignature:
+		return 4
+	case OperandInt64, OperandFloat64:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// IsToken returns true if the operand is a metadata token.
+func (kind OperandKind) IsToken() bool {
+	switch kind {
+	case OperandField, OperandMethod, OperandType, OperandToken, OperandString, OperandSignature:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,87 @@
+package clrheader
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flags holds the runtime flags of a CLR header. They are the flags that
+// are reported by the CorFlags tool.
+type Flags uint32
+
+// CLR header flags.
+//
+// https://learn.microsoft.com/en-us/dotnet/framework/unmanaged-api/metadata/corhdr-h
+const (
+	FlagILOnly           Flags = 0x00000001 // COMIMAGE_FLAGS_ILONLY, The image contains only intermediate language code
+	Flag32BitRequired    Flags = 0x00000002 // COMIMAGE_FLAGS_32BITREQUIRED, The image can only be loaded into a 32-bit process
+	FlagILLibrary        Flags = 0x00000004 // COMIMAGE_FLAGS_IL_LIBRARY, The image is an IL library
+	FlagStrongNameSigned Flags = 0x00000008 // COMIMAGE_FLAGS_STRONGNAMESIGNED, The image has a strong name signature
+	FlagNativeEntryPoint Flags = 0x00000010 // COMIMAGE_FLAGS_NATIVE_ENTRYPOINT, The entry point is the address of native code rather than a metadata token
+	FlagTrackDebugData   Flags = 0x00010000 // COMIMAGE_FLAGS_TRACKDEBUGDATA, The runtime should track debug data
+	Flag32BitPreferred   Flags = 0x00020000 // COMIMAGE_FLAGS_32BITPREFERRED, The image should be loaded into a 32-bit process when possible
+)
+
+var flagNames = []struct {
+	flag Flags
+	name string
+}{
+	{FlagILOnly, "ILONLY"},
+	{Flag32BitRequired, "32BITREQUIRED"},
+	{FlagILLibrary, "IL_LIBRARY"},
+	{FlagStrongNameSigned, "STRONGNAMESIGNED"},
+	{FlagNativeEntryPoint, "NATIVE_ENTRYPOINT"},
+	{FlagTrackDebugData, "TRACKDEBUGDATA"},
+	{Flag32BitPreferred, "32BITPREFERRED"},
+}
+
+// ILOnly returns true if the image contains only intermediate language
+// code.
+func (flags Flags) ILOnly() bool {
+	return flags&FlagILOnly != 0
+}
+
+// Requires32Bit returns true if the image can only be loaded into a 32-bit
+// process.
+//
+// Images that have both the 32BITREQUIRED and 32BITPREFERRED flags set
+// prefer a 32-bit process but don't require one.
+func (flags Flags) Requires32Bit() bool {
+	return flags&Flag32BitRequired != 0 && flags&Flag32BitPreferred == 0
+}
+
+// Prefers32Bit returns true if the image should be loaded into a 32-bit
+// process when possible.
+func (flags Flags) Prefers32Bit() bool {
+	return flags&Flag32BitRequired != 0 && flags&Flag32BitPreferred != 0
+}
+
+// StrongNameSigned returns true if the image has a strong name signature.
+func (flags Flags) StrongNameSigned() bool {
+	return flags&FlagStrongNameSigned != 0
+}
+
+// NativeEntryPoint returns true if the entry point of the image is the
+// relative virtual address of native code rather than a metadata token.
+func (flags Flags) NativeEntryPoint() bool {
+	return flags&FlagNativeEntryPoint != 0
+}
+
+// String returns a string representation of the flags.
+func (flags Flags) String() string {
+	var names []string
+	remaining := flags
+	for _, entry := range flagNames {
+		if flags&entry.flag != 0 {
+			names = append(names, entry.name)
+			remaining &^= entry.flag
+		}
+	}
+	if remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(remaining)))
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, " | ")
+}
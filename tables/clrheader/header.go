@@ -0,0 +1,120 @@
+package clrheader
+
+import (
+	"encoding/binary"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// headerSize is the size of an IMAGE_COR20_HEADER structure.
+const headerSize = 72
+
+// Directory describes the location of a block of data that is referenced
+// by the CLR header.
+type Directory struct {
+	// Address is the relative virtual address range of the data when it
+	// is loaded.
+	Address imagefile.RelativeVirtualAddressRange
+
+	// Location is the file range of the data.
+	Location imagefile.FileRange
+}
+
+// IsZero returns true if the directory is not present within the image
+// file.
+func (dir Directory) IsZero() bool {
+	return dir.Address.Start == 0 && dir.Address.Length == 0
+}
+
+// Header is a CLR runtime header, which describes the managed code and
+// metadata within an image file. It is the IMAGE_COR20_HEADER structure.
+type Header struct {
+	Size                uint32
+	MajorRuntimeVersion uint16
+	MinorRuntimeVersion uint16
+	Metadata            Directory
+	Flags               Flags
+
+	// EntryPoint holds either the metadata token of the managed entry
+	// point method or, if the NATIVE_ENTRYPOINT flag is set, the relative
+	// virtual address of a native entry point. It is zero if the image
+	// doesn't have an entry point.
+	EntryPoint uint32
+
+	Resources               Directory
+	StrongNameSignature     Directory
+	CodeManagerTable        Directory
+	VTableFixups            Directory
+	ExportAddressTableJumps Directory
+	ManagedNativeHeader     Directory
+}
+
+// EntryPointToken returns the metadata token of the managed entry point.
+// It returns false if the image has a native entry point or no entry
+// point at all.
+func (header Header) EntryPointToken() (token uint32, ok bool) {
+	if header.Flags.NativeEntryPoint() || header.EntryPoint == 0 {
+		return 0, false
+	}
+	return header.EntryPoint, true
+}
+
+// EntryPointAddress returns the relative virtual address of the native
+// entry point. It returns false if the image has a managed entry point or
+// no entry point at all.
+func (header Header) EntryPointAddress() (address imagefile.RelativeVirtualAddress, ok bool) {
+	if !header.Flags.NativeEntryPoint() || header.EntryPoint == 0 {
+		return 0, false
+	}
+	return imagefile.RelativeVirtualAddress(header.EntryPoint), true
+}
+
+type headerData []byte
+
+func (header headerData) Size() uint32 {
+	return binary.LittleEndian.Uint32(header[0:4])
+}
+
+func (header headerData) MajorRuntimeVersion() uint16 {
+	return binary.LittleEndian.Uint16(header[4:6])
+}
+
+func (header headerData) MinorRuntimeVersion() uint16 {
+	return binary.LittleEndian.Uint16(header[6:8])
+}
+
+func (header headerData) Metadata() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[8:16])
+}
+
+func (header headerData) Flags() Flags {
+	return Flags(binary.LittleEndian.Uint32(header[16:20]))
+}
+
+func (header headerData) EntryPoint() uint32 {
+	return binary.LittleEndian.Uint32(header[20:24])
+}
+
+func (header headerData) Resources() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[24:32])
+}
+
+func (header headerData) StrongNameSignature() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[32:40])
+}
+
+func (header headerData) CodeManagerTable() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[40:48])
+}
+
+func (header headerData) VTableFixups() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[48:56])
+}
+
+func (header headerData) ExportAddressTableJumps() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[56:64])
+}
+
+func (header headerData) ManagedNativeHeader() imagefile.DataDirectory {
+	return imagefile.DataDirectory(header[64:72])
+}
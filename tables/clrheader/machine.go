@@ -0,0 +1,75 @@
+package clrheader
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// OperatingSystem identifies the operating system targeted by the native
+// code of a ReadyToRun image. ReadyToRun images that target operating
+// systems other than Windows record it by XORing the machine type of the
+// image with one of these values.
+type OperatingSystem uint16
+
+// ReadyToRun operating systems.
+const (
+	OSWindows OperatingSystem = 0x0000 // IMAGE_FILE_MACHINE_OS_MASK_WINDOWS
+	OSApple   OperatingSystem = 0x4644 // IMAGE_FILE_MACHINE_OS_MASK_APPLE
+	OSFreeBSD OperatingSystem = 0xADC4 // IMAGE_FILE_MACHINE_OS_MASK_FREEBSD
+	OSLinux   OperatingSystem = 0x7B79 // IMAGE_FILE_MACHINE_OS_MASK_LINUX
+	OSNetBSD  OperatingSystem = 0x1993 // IMAGE_FILE_MACHINE_OS_MASK_NETBSD
+	OSSunOS   OperatingSystem = 0x1992 // IMAGE_FILE_MACHINE_OS_MASK_SUN
+)
+
+// String returns a string representation of the operating system.
+func (os OperatingSystem) String() string {
+	switch os {
+	case OSWindows:
+		return "Windows"
+	case OSApple:
+		return "Apple"
+	case OSFreeBSD:
+		return "FreeBSD"
+	case OSLinux:
+		return "Linux"
+	case OSNetBSD:
+		return "NetBSD"
+	case OSSunOS:
+		return "SunOS"
+	default:
+		return fmt.Sprintf("<unrecognized operating system: %x>", uint16(os))
+	}
+}
+
+// readyToRunMachines are the machine types supported by ReadyToRun
+// compilers.
+var readyToRunMachines = []imagefile.Machine{
+	imagefile.MachineX86,
+	imagefile.MachineAMD64,
+	imagefile.MachineARMNT,
+	imagefile.MachineARM64,
+	imagefile.MachineLoongArch64,
+	imagefile.MachineRISCV64,
+}
+
+// readyToRunOperatingSystems are the operating systems that may be XORed
+// with the machine type of a ReadyToRun image.
+var readyToRunOperatingSystems = []OperatingSystem{
+	OSWindows, OSApple, OSFreeBSD, OSLinux, OSNetBSD, OSSunOS,
+}
+
+// SplitMachine separates the machine type of a ReadyToRun image into the
+// machine and operating system that its native code targets. It returns
+// false if machine is not a supported combination of the two.
+func SplitMachine(machine imagefile.Machine) (imagefile.Machine, OperatingSystem, bool) {
+	for _, os := range readyToRunOperatingSystems {
+		candidate := machine ^ imagefile.Machine(os)
+		for _, supported := range readyToRunMachines {
+			if candidate == supported {
+				return candidate, os, true
+			}
+		}
+	}
+	return machine, OSWindows, false
+}
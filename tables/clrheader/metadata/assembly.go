@@ -0,0 +1,186 @@
+package metadata
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrMissingAssembly is returned when the metadata doesn't describe an
+// assembly, as is the case for modules that belong to a multi-file
+// assembly.
+var ErrMissingAssembly = errors.New("the metadata does not describe an assembly")
+
+// AssemblyFlags holds flags that describe an assembly or an assembly
+// reference.
+type AssemblyFlags uint32
+
+// Assembly flags.
+//
+// ECMA-335 II.23.1.2
+const (
+	AssemblyPublicKey             AssemblyFlags = 0x0001 // The assembly holds the full public key rather than a public key token
+	AssemblyRetargetable          AssemblyFlags = 0x0100 // The implementation of the assembly may come from a different assembly at runtime
+	AssemblyWindowsRuntime        AssemblyFlags = 0x0200 // The assembly holds Windows Runtime metadata
+	AssemblyDisableJITOptimizer   AssemblyFlags = 0x4000 // The JIT compiler should not optimize the assembly
+	AssemblyEnableJITTracking     AssemblyFlags = 0x8000 // The JIT compiler should track the assembly
+	assemblyContentTypeMask       AssemblyFlags = 0x0E00
+	assemblyProcessorArchitecture AssemblyFlags = 0x0070
+)
+
+// HasPublicKey returns true if the full public key is present, rather
+// than a public key token.
+func (flags AssemblyFlags) HasPublicKey() bool {
+	return flags&AssemblyPublicKey != 0
+}
+
+// Retargetable returns true if the assembly is retargetable.
+func (flags AssemblyFlags) Retargetable() bool {
+	return flags&AssemblyRetargetable != 0
+}
+
+// WindowsRuntime returns true if the content type of the assembly is
+// Windows Runtime metadata.
+func (flags AssemblyFlags) WindowsRuntime() bool {
+	return flags&assemblyContentTypeMask == AssemblyWindowsRuntime
+}
+
+// HashAlgorithm identifies the hash algorithm used for the files of an
+// assembly.
+type HashAlgorithm uint32
+
+// Hash algorithms.
+const (
+	HashNone   HashAlgorithm = 0x0000 // No hash algorithm
+	HashMD5    HashAlgorithm = 0x8003 // MD5
+	HashSHA1   HashAlgorithm = 0x8004 // SHA-1
+	HashSHA256 HashAlgorithm = 0x800C // SHA-256
+	HashSHA384 HashAlgorithm = 0x800D // SHA-384
+	HashSHA512 HashAlgorithm = 0x800E // SHA-512
+)
+
+// String returns a string representation of the hash algorithm.
+func (alg HashAlgorithm) String() string {
+	switch alg {
+	case HashNone:
+		return "None"
+	case HashMD5:
+		return "MD5"
+	case HashSHA1:
+		return "SHA1"
+	case HashSHA256:
+		return "SHA256"
+	case HashSHA384:
+		return "SHA384"
+	case HashSHA512:
+		return "SHA512"
+	default:
+		return fmt.Sprintf("<unrecognized hash algorithm: %x>", uint32(alg))
+	}
+}
+
+// AssemblyName identifies an assembly by its name, version, culture and
+// public key.
+type AssemblyName struct {
+	Name    string
+	Version Version
+	Culture string
+	Flags   AssemblyFlags
+
+	// PublicKey holds the full public key of the assembly, if it is known.
+	// References to other assemblies usually only hold the public key
+	// token.
+	PublicKey []byte
+
+	// PublicKeyToken holds the last 8 bytes of the SHA-1 hash of the
+	// public key, in reverse order. It is empty for assemblies that don't
+	// have a strong name.
+	PublicKeyToken []byte
+}
+
+// String returns the display name of the assembly, as it would be
+// formatted by the runtime.
+func (name AssemblyName) String() string {
+	var b strings.Builder
+	b.WriteString(name.Name)
+	fmt.Fprintf(&b, ", Version=%s", name.Version)
+	if name.Culture == "" {
+		b.WriteString(", Culture=neutral")
+	} else {
+		fmt.Fprintf(&b, ", Culture=%s", name.Culture)
+	}
+	if len(name.PublicKeyToken) == 0 {
+		b.WriteString(", PublicKeyToken=null")
+	} else {
+		fmt.Fprintf(&b, ", PublicKeyToken=%s", hex.EncodeToString(name.PublicKeyToken))
+	}
+	if name.Flags.Retargetable() {
+		b.WriteString(", Retargetable=Yes")
+	}
+	if name.Flags.WindowsRuntime() {
+		b.WriteString(", ContentType=WindowsRuntime")
+	}
+	return b.String()
+}
+
+// PublicKeyToken computes the public key token for the given public key,
+// which is the last 8 bytes of its SHA-1 hash in reverse order.
+func PublicKeyToken(publicKey []byte) []byte {
+	if len(publicKey) == 0 {
+		return nil
+	}
+	sum := sha1.Sum(publicKey)
+	token := slices.Clone(sum[len(sum)-8:])
+	slices.Reverse(token)
+	return token
+}
+
+// AssemblyName returns the identity of the assembly described by the
+// metadata. It returns [ErrMissingAssembly] if the metadata doesn't
+// describe an assembly.
+func (r *Reader) AssemblyName() (AssemblyName, error) {
+	if r.RowCount(TableAssembly) == 0 {
+		return AssemblyName{}, ErrMissingAssembly
+	}
+	assembly, err := r.Assembly(1)
+	if err != nil {
+		return AssemblyName{}, err
+	}
+	return AssemblyName{
+		Name:           assembly.Name,
+		Version:        assembly.Version,
+		Culture:        assembly.Culture,
+		Flags:          assembly.Flags,
+		PublicKey:      assembly.PublicKey,
+		PublicKeyToken: PublicKeyToken(assembly.PublicKey),
+	}, nil
+}
+
+// AssemblyReferences returns the identities of the assemblies that are
+// referenced by the metadata.
+func (r *Reader) AssemblyReferences() ([]AssemblyName, error) {
+	refs, err := r.AssemblyRefTable()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]AssemblyName, 0, len(refs))
+	for _, ref := range refs {
+		name := AssemblyName{
+			Name:    ref.Name,
+			Version: ref.Version,
+			Culture: ref.Culture,
+			Flags:   ref.Flags,
+		}
+		if ref.Flags.HasPublicKey() {
+			name.PublicKey = ref.PublicKeyOrToken
+			name.PublicKeyToken = PublicKeyToken(ref.PublicKeyOrToken)
+		} else {
+			name.PublicKeyToken = ref.PublicKeyOrToken
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,559 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Argument is a value within a custom attribute.
+type Argument struct {
+	// Type is the type of the value. Enum values have a type of
+	// ElementEnum and arrays have a type of ElementSZArray.
+	Type ElementType
+
+	// EnumType is the name of the type of an enum value.
+	EnumType string
+
+	// Value holds the value of the argument:
+	//
+	//   - bool for ElementBoolean
+	//   - rune for ElementChar, which must be distinguished from int32 by
+	//     its Type
+	//   - int8, uint8, int16, uint16, int32, uint32, int64 or uint64 for
+	//     integers, and for enums with the corresponding underlying type
+	//   - float32 or float64 for floating point numbers
+	//   - string for ElementString, and the assembly-qualified type name for
+	//     ElementSystemType
+	//   - []Argument for ElementSZArray
+	//
+	// It is nil for null strings, types and arrays.
+	Value any
+}
+
+// String returns a string representation of the argument, formatted in
+// the style of C#.
+func (arg Argument) String() string {
+	if arg.Value == nil {
+		return "null"
+	}
+	switch arg.Type {
+	case ElementString:
+		return strconv.Quote(arg.Value.(string))
+	case ElementSystemType:
+		return "typeof(" + arg.Value.(string) + ")"
+	case ElementChar:
+		return strconv.QuoteRune(arg.Value.(rune))
+	case ElementSZArray:
+		values := arg.Value.([]Argument)
+		elems := make([]string, len(values))
+		for i := range values {
+			elems[i] = values[i].String()
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case ElementEnum:
+		return fmt.Sprintf("(%s)%v", arg.EnumType, arg.Value)
+	default:
+		return fmt.Sprint(arg.Value)
+	}
+}
+
+// NamedArgument is a field or property value within a custom attribute.
+type NamedArgument struct {
+	Property bool // True for a property, false for a field
+	Name     string
+	Argument
+}
+
+// String returns a string representation of the named argument.
+func (arg NamedArgument) String() string {
+	return arg.Name + " = " + arg.Argument.String()
+}
+
+// CustomAttributeValue is the decoded value of a custom attribute.
+type CustomAttributeValue struct {
+	Type  TypeName        // The type of the attribute
+	Fixed []Argument      // The arguments passed to the constructor
+	Named []NamedArgument // The fields and properties that are assigned
+}
+
+// String returns a string representation of the attribute, formatted in
+// the style of C#.
+func (value CustomAttributeValue) String() string {
+	args := make([]string, 0, len(value.Fixed)+len(value.Named))
+	for _, arg := range value.Fixed {
+		args = append(args, arg.String())
+	}
+	for _, arg := range value.Named {
+		args = append(args, arg.String())
+	}
+	return value.Type.String() + "(" + strings.Join(args, ", ") + ")"
+}
+
+// CustomAttributes returns the custom attributes that are applied to the
+// metadata entity with the given token.
+func (r *Reader) CustomAttributes(parent Token) ([]CustomAttribute, error) {
+	attributes, err := r.CustomAttributeTable()
+	if err != nil {
+		return nil, err
+	}
+	var matched []CustomAttribute
+	for _, attribute := range attributes {
+		if attribute.Parent == parent {
+			matched = append(matched, attribute)
+		}
+	}
+	return matched, nil
+}
+
+// EnumResolver returns the underlying type of an enum that is defined in
+// another assembly. The name of the enum may be qualified by the name of
+// its assembly. It returns false if the enum is not known.
+type EnumResolver func(name string) (ElementType, bool)
+
+// DecodeCustomAttribute decodes the value of the given custom attribute,
+// using the signature of its constructor to determine the types of its
+// arguments.
+//
+// The underlying type of an enum that is defined in the same module is
+// read from the metadata. The underlying type of an enum that is defined
+// elsewhere is provided by enums, if it is not nil. Otherwise the enum is
+// assumed to have an underlying type of int32, which is true of the vast
+// majority of enums.
+func (r *Reader) DecodeCustomAttribute(attribute CustomAttribute, enums EnumResolver) (CustomAttributeValue, error) {
+	var value CustomAttributeValue
+	var err error
+	if value.Type, err = r.AttributeType(attribute); err != nil {
+		return CustomAttributeValue{}, err
+	}
+
+	// Determine the types of the constructor's parameters, and the type
+	// arguments of a generic attribute type.
+	var signature []byte
+	var generics []SignatureType
+	switch attribute.Type.Table() {
+	case TableMethodDef:
+		method, err := r.MethodDef(attribute.Type.Row())
+		if err != nil {
+			return CustomAttributeValue{}, err
+		}
+		signature = method.Signature
+	case TableMemberRef:
+		ref, err := r.MemberRef(attribute.Type.Row())
+		if err != nil {
+			return CustomAttributeValue{}, err
+		}
+		signature = ref.Signature
+		if ref.Class.Table() == TableTypeSpec {
+			if generics, err = r.typeSpecArgs(ref.Class); err != nil {
+				return CustomAttributeValue{}, err
+			}
+		}
+	}
+	ctor, err := ParseMethodSignature(signature)
+	if err != nil {
+		return CustomAttributeValue{}, fmt.Errorf("failed to parse the constructor signature of %s: %w", value.Type, err)
+	}
+
+	d := attributeDecoder{r: r, data: attribute.Value, enums: enums}
+	prolog, err := d.uint16()
+	if err != nil {
+		return CustomAttributeValue{}, err
+	}
+	if prolog != attributeProlog {
+		return CustomAttributeValue{}, fmt.Errorf("the value of %s does not have the expected prolog", value.Type)
+	}
+
+	for i, param := range ctor.Params {
+		if param.Element == ElementVar && int(param.Number) < len(generics) {
+			param = generics[param.Number]
+		}
+		t, err := r.argumentType(param, enums)
+		if err != nil {
+			return CustomAttributeValue{}, fmt.Errorf("failed to determine the type of argument %d of %s: %w", i, value.Type, err)
+		}
+		arg, err := d.argument(t)
+		if err != nil {
+			return CustomAttributeValue{}, fmt.Errorf("failed to decode argument %d of %s: %w", i, value.Type, err)
+		}
+		value.Fixed = append(value.Fixed, arg)
+	}
+
+	// The named arguments are optional when there aren't any.
+	if d.pos == len(d.data) {
+		return value, nil
+	}
+	count, err := d.uint16()
+	if err != nil {
+		return CustomAttributeValue{}, err
+	}
+	for range count {
+		kind, err := d.byte()
+		if err != nil {
+			return CustomAttributeValue{}, err
+		}
+		if ElementType(kind) != ElementField && ElementType(kind) != ElementProperty {
+			return CustomAttributeValue{}, fmt.Errorf("the value of %s has a named argument with an invalid kind of 0x%02x", value.Type, kind)
+		}
+		t, err := d.fieldOrPropType()
+		if err != nil {
+			return CustomAttributeValue{}, err
+		}
+		name, err := d.serString()
+		if err != nil {
+			return CustomAttributeValue{}, err
+		}
+		arg, err := d.argument(t)
+		if err != nil {
+			return CustomAttributeValue{}, fmt.Errorf("failed to decode named argument \"%s\" of %s: %w", name, value.Type, err)
+		}
+		value.Named = append(value.Named, NamedArgument{
+			Property: ElementType(kind) == ElementProperty,
+			Name:     name,
+			Argument: arg,
+		})
+	}
+
+	return value, nil
+}
+
+// typeSpecArgs returns the type arguments of a generic instantiation
+// described by the given TypeSpec token.
+func (r *Reader) typeSpecArgs(token Token) ([]SignatureType, error) {
+	spec, err := r.TypeSpec(token.Row())
+	if err != nil {
+		return nil, err
+	}
+	t, err := ParseTypeSignature(spec.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return t.Args, nil
+}
+
+// argumentType describes the type of a custom attribute argument.
+type argumentType struct {
+	element    ElementType
+	enum       string        // The name of an enum type
+	underlying ElementType   // The underlying type of an enum
+	elem       *argumentType // The element type of an array
+}
+
+// argumentType converts the type of a constructor parameter to the type
+// of a custom attribute argument.
+func (r *Reader) argumentType(t SignatureType, enums EnumResolver) (argumentType, error) {
+	switch t.Element {
+	case ElementBoolean, ElementChar, ElementInt8, ElementUint8, ElementInt16,
+		ElementUint16, ElementInt32, ElementUint32, ElementInt64, ElementUint64,
+		ElementFloat32, ElementFloat64, ElementString:
+		return argumentType{element: t.Element}, nil
+	case ElementObject:
+		return argumentType{element: ElementBoxed}, nil
+	case ElementSZArray:
+		elem, err := r.argumentType(*t.Elem, enums)
+		if err != nil {
+			return argumentType{}, err
+		}
+		return argumentType{element: ElementSZArray, elem: &elem}, nil
+	case ElementClass:
+		name, err := r.TypeName(t.Token)
+		if err != nil {
+			return argumentType{}, err
+		}
+		if name.Namespace == "System" && name.Name == "Type" {
+			return argumentType{element: ElementSystemType}, nil
+		}
+		return argumentType{}, fmt.Errorf("the class %s is not a valid custom attribute argument type", name)
+	case ElementValueType:
+		// The only value types that are permitted are enums.
+		name, err := r.TypeName(t.Token)
+		if err != nil {
+			return argumentType{}, err
+		}
+		underlying, err := r.enumUnderlyingType(t.Token, name.String(), enums)
+		if err != nil {
+			return argumentType{}, err
+		}
+		return argumentType{element: ElementEnum, enum: name.String(), underlying: underlying}, nil
+	default:
+		return argumentType{}, fmt.Errorf("the element type %s is not a valid custom attribute argument type", t.Element)
+	}
+}
+
+// enumUnderlyingType determines the underlying type of the enum with the
+// given TypeDef or TypeRef token. The token is zero for an enum that is
+// only known by its name.
+func (r *Reader) enumUnderlyingType(token Token, name string, enums EnumResolver) (ElementType, error) {
+	if token.IsNil() {
+		token = r.findTypeDef(name)
+	}
+	if token.Table() == TableTypeDef && !token.IsNil() {
+		return r.enumFieldType(token.Row())
+	}
+	if enums != nil {
+		if underlying, ok := enums(name); ok {
+			return underlying, nil
+		}
+	}
+	return ElementInt32, nil
+}
+
+// findTypeDef returns the TypeDef token of the type with the given
+// serialized name, which may be assembly-qualified and uses '+' to
+// separate the names of nested types from their enclosing types. It
+// returns a nil token if the metadata doesn't define the type.
+func (r *Reader) findTypeDef(name string) Token {
+	if cutoff := strings.IndexByte(name, ','); cutoff >= 0 {
+		name = name[:cutoff]
+	}
+	r.typeDefsOnce.Do(r.indexTypeDefs)
+	return r.typeDefs[strings.TrimSpace(name)]
+}
+
+// indexTypeDefs builds the index of TypeDef tokens that is used by
+// findTypeDef. When several types share a name, the first one is kept.
+func (r *Reader) indexTypeDefs() {
+	defs, err := r.TypeDefTable()
+	if err != nil {
+		return
+	}
+
+	names := make([]string, len(defs))
+	var resolve func(row uint32, depth int) string
+	resolve = func(row uint32, depth int) string {
+		if names[row-1] != "" {
+			return names[row-1]
+		}
+		def := defs[row-1]
+		name := TypeName{Namespace: def.Namespace, Name: def.Name}.String()
+		if depth < maxNestingDepth {
+			enclosing, err := r.enclosingType(row)
+			if err == nil && enclosing.Table() == TableTypeDef && !enclosing.IsNil() && enclosing.Row() <= uint32(len(defs)) {
+				name = resolve(enclosing.Row(), depth+1) + "+" + name
+			}
+		}
+		names[row-1] = name
+		return name
+	}
+
+	r.typeDefs = make(map[string]Token, len(defs))
+	for i := range defs {
+		name := resolve(uint32(i+1), 0)
+		if _, exists := r.typeDefs[name]; !exists {
+			r.typeDefs[name] = NewToken(TableTypeDef, uint32(i+1))
+		}
+	}
+}
+
+// enumFieldType returns the type of the instance field of the enum with
+// the given TypeDef row, which holds its value.
+func (r *Reader) enumFieldType(row uint32) (ElementType, error) {
+	def, err := r.TypeDef(row)
+	if err != nil {
+		return 0, err
+	}
+	end := r.RowCount(TableField) + 1
+	if row < r.RowCount(TableTypeDef) {
+		next, err := r.TypeDef(row + 1)
+		if err != nil {
+			return 0, err
+		}
+		end = next.FieldList
+	}
+	const fieldStatic = 0x0010
+	for i := def.FieldList; i < end; i++ {
+		field, err := r.Field(i)
+		if err != nil {
+			return 0, err
+		}
+		if field.Flags&fieldStatic != 0 {
+			continue
+		}
+		t, err := ParseFieldSignature(field.Signature)
+		if err != nil {
+			return 0, err
+		}
+		return t.Element, nil
+	}
+	return 0, fmt.Errorf("the enum %s.%s does not have an instance field", def.Namespace, def.Name)
+}
+
+// attributeDecoder reads the values within a custom attribute blob.
+type attributeDecoder struct {
+	r     *Reader
+	data  []byte
+	pos   int
+	enums EnumResolver
+	depth int
+}
+
+func (d *attributeDecoder) take(n int) ([]byte, error) {
+	if n > len(d.data)-d.pos {
+		return nil, fmt.Errorf("the custom attribute value is truncated")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *attributeDecoder) byte() (byte, error) {
+	b, err := d.take(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *attributeDecoder) uint16() (uint16, error) {
+	b, err := d.take(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (d *attributeDecoder) serString() (string, error) {
+	s, n, err := readSerString(d.data[d.pos:])
+	if err != nil {
+		return "", err
+	}
+	d.pos += n
+	return s, nil
+}
+
+// nullableString reads a serialized string that may be null.
+func (d *attributeDecoder) nullableString() (any, error) {
+	if d.pos < len(d.data) && d.data[d.pos] == 0xFF {
+		d.pos++
+		return nil, nil
+	}
+	return d.serString()
+}
+
+// fieldOrPropType reads the type of a boxed value or named argument.
+func (d *attributeDecoder) fieldOrPropType() (argumentType, error) {
+	b, err := d.byte()
+	if err != nil {
+		return argumentType{}, err
+	}
+	t := argumentType{element: ElementType(b)}
+	switch t.element {
+	case ElementBoolean, ElementChar, ElementInt8, ElementUint8, ElementInt16,
+		ElementUint16, ElementInt32, ElementUint32, ElementInt64, ElementUint64,
+		ElementFloat32, ElementFloat64, ElementString, ElementSystemType, ElementBoxed:
+	case ElementSZArray:
+		elem, err := d.fieldOrPropType()
+		if err != nil {
+			return argumentType{}, err
+		}
+		t.elem = &elem
+	case ElementEnum:
+		if t.enum, err = d.serString(); err != nil {
+			return argumentType{}, err
+		}
+		if t.underlying, err = d.r.enumUnderlyingType(0, t.enum, d.enums); err != nil {
+			return argumentType{}, err
+		}
+	default:
+		return argumentType{}, fmt.Errorf("the custom attribute value has an invalid element type of 0x%02x", b)
+	}
+	return t, nil
+}
+
+// argument reads a value of the given type.
+func (d *attributeDecoder) argument(t argumentType) (Argument, error) {
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > maxSignatureDepth {
+		return Argument{}, fmt.Errorf("the custom attribute value exceeds the maximum nesting depth of %d", maxSignatureDepth)
+	}
+
+	switch t.element {
+	case ElementString, ElementSystemType:
+		value, err := d.nullableString()
+		return Argument{Type: t.element, Value: value}, err
+	case ElementBoxed:
+		// A boxed value is preceded by its actual type.
+		actual, err := d.fieldOrPropType()
+		if err != nil {
+			return Argument{}, err
+		}
+		return d.argument(actual)
+	case ElementEnum:
+		value, err := d.primitive(t.underlying)
+		return Argument{Type: ElementEnum, EnumType: t.enum, Value: value}, err
+	case ElementSZArray:
+		b, err := d.take(4)
+		if err != nil {
+			return Argument{}, err
+		}
+		count := binary.LittleEndian.Uint32(b)
+		if count == math.MaxUint32 {
+			return Argument{Type: ElementSZArray}, nil
+		}
+		if int(count) > len(d.data)-d.pos {
+			return Argument{}, fmt.Errorf("the custom attribute array has %d elements that exceed the length of the value", count)
+		}
+		elems := make([]Argument, 0, count)
+		for range count {
+			elem, err := d.argument(*t.elem)
+			if err != nil {
+				return Argument{}, err
+			}
+			elems = append(elems, elem)
+		}
+		return Argument{Type: ElementSZArray, Value: elems}, nil
+	default:
+		value, err := d.primitive(t.element)
+		return Argument{Type: t.element, Value: value}, err
+	}
+}
+
+// primitive reads a value with the given primitive type.
+func (d *attributeDecoder) primitive(element ElementType) (any, error) {
+	var size int
+	switch element {
+	case ElementBoolean, ElementInt8, ElementUint8:
+		size = 1
+	case ElementChar, ElementInt16, ElementUint16:
+		size = 2
+	case ElementInt32, ElementUint32, ElementFloat32:
+		size = 4
+	case ElementInt64, ElementUint64, ElementFloat64:
+		size = 8
+	default:
+		return nil, fmt.Errorf("the element type %s is not a primitive type", element)
+	}
+	b, err := d.take(size)
+	if err != nil {
+		return nil, err
+	}
+	switch element {
+	case ElementBoolean:
+		return b[0] != 0, nil
+	case ElementInt8:
+		return int8(b[0]), nil
+	case ElementUint8:
+		return b[0], nil
+	case ElementChar:
+		return rune(binary.LittleEndian.Uint16(b)), nil
+	case ElementInt16:
+		return int16(binary.LittleEndian.Uint16(b)), nil
+	case ElementUint16:
+		return binary.LittleEndian.Uint16(b), nil
+	case ElementInt32:
+		return int32(binary.LittleEndian.Uint32(b)), nil
+	case ElementUint32:
+		return binary.LittleEndian.Uint32(b), nil
+	case ElementFloat32:
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case ElementInt64:
+		return int64(binary.LittleEndian.Uint64(b)), nil
+	case ElementUint64:
+		return binary.LittleEndian.Uint64(b), nil
+	default:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	}
+}
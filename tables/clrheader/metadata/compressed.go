@@ -0,0 +1,55 @@
+package metadata
+
+import "fmt"
+
+// DecodeCompressedUint decodes an unsigned integer that has been
+// compressed using the encoding from ECMA-335 II.23.2. It returns the
+// value and the number of bytes that it occupied.
+func DecodeCompressedUint(data []byte) (value uint32, n int, err error) {
+	if len(data) == 0 {
+		return 0, 0, fmt.Errorf("the compressed integer is missing")
+	}
+	switch {
+	case data[0]&0x80 == 0:
+		return uint32(data[0]), 1, nil
+	case data[0]&0xC0 == 0x80:
+		if len(data) < 2 {
+			return 0, 0, fmt.Errorf("the 2 byte compressed integer is truncated")
+		}
+		return uint32(data[0]&0x3F)<<8 | uint32(data[1]), 2, nil
+	case data[0]&0xE0 == 0xC0:
+		if len(data) < 4 {
+			return 0, 0, fmt.Errorf("the 4 byte compressed integer is truncated")
+		}
+		return uint32(data[0]&0x1F)<<24 | uint32(data[1])<<16 | uint32(data[2])<<8 | uint32(data[3]), 4, nil
+	default:
+		return 0, 0, fmt.Errorf("the compressed integer has an invalid leading byte of 0x%02x", data[0])
+	}
+}
+
+// DecodeCompressedInt decodes a signed integer that has been compressed
+// using the encoding from ECMA-335 II.23.2. It returns the value and the
+// number of bytes that it occupied.
+func DecodeCompressedInt(data []byte) (value int32, n int, err error) {
+	raw, n, err := DecodeCompressedUint(data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The value is rotated left by one bit, so that the sign bit is stored
+	// in the least significant bit.
+	var bits uint
+	switch n {
+	case 1:
+		bits = 7
+	case 2:
+		bits = 14
+	default:
+		bits = 29
+	}
+	value = int32(raw >> 1)
+	if raw&1 != 0 {
+		value -= 1 << (bits - 1)
+	}
+	return value, n, nil
+}
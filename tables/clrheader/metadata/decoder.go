@@ -0,0 +1,78 @@
+package metadata
+
+import "fmt"
+
+// rowDecoder converts the column values of a row into typed values. It
+// records the first error that it encounters so that row decoding
+// functions can remain simple.
+type rowDecoder struct {
+	r   *Reader
+	err error
+}
+
+func (d *rowDecoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *rowDecoder) string(value uint32) string {
+	s, err := d.r.String(value)
+	if err != nil {
+		d.fail(err)
+	}
+	return s
+}
+
+func (d *rowDecoder) guid(value uint32) GUID {
+	g, err := d.r.GUID(value)
+	if err != nil {
+		d.fail(err)
+	}
+	return g
+}
+
+func (d *rowDecoder) blob(value uint32) []byte {
+	b, err := d.r.Blob(value)
+	if err != nil {
+		d.fail(err)
+	}
+	return b
+}
+
+func (d *rowDecoder) coded(coded *codedIndex, value uint32) Token {
+	token, err := decodeCodedIndex(coded, value)
+	if err != nil {
+		d.fail(err)
+	}
+	return token
+}
+
+// readRow reads and decodes a single row of a table.
+func readRow[T any](r *Reader, table TableID, row uint32, decode func(*rowDecoder, [maxColumns]uint32) T) (T, error) {
+	var zero T
+	values, err := r.tables.row(table, row)
+	if err != nil {
+		return zero, err
+	}
+	d := rowDecoder{r: r}
+	result := decode(&d, values)
+	if d.err != nil {
+		return zero, fmt.Errorf("failed to decode row %d of the %s table: %w", row, table, d.err)
+	}
+	return result, nil
+}
+
+// readRows reads and decodes all of the rows of a table.
+func readRows[T any](r *Reader, table TableID, decode func(*rowDecoder, [maxColumns]uint32) T) ([]T, error) {
+	count := r.tables.rows[table]
+	rows := make([]T, 0, count)
+	for row := uint32(1); row <= count; row++ {
+		result, err := readRow(r, table, row, decode)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, result)
+	}
+	return rows, nil
+}
@@ -0,0 +1,262 @@
+package metadata
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxNestingDepth limits the depth of nested types that are followed when
+// formatting the name of a type.
+const maxNestingDepth = 64
+
+// DisplayName returns a human-readable name for the type or member
+// referred to by the given token, in the style of IL disassemblers.
+//
+// Types are named by their full names, with nested types separated from
+// their enclosing types by a slash, such as "System.Environment/
+// SpecialFolder". Constructed types are formatted from their signatures,
+// such as "System.Collections.Generic.List`1<string>". Methods and fields
+// are named by their types and names, separated by two colons, such as
+// "System.Diagnostics.Process::Start".
+func (r *Reader) DisplayName(token Token) (string, error) {
+	switch token.Table() {
+	case TableTypeDef, TableTypeRef:
+		return r.typeDisplayName(token, 0)
+	case TableTypeSpec:
+		spec, err := r.TypeSpec(token.Row())
+		if err != nil {
+			return "", err
+		}
+		t, err := ParseTypeSignature(spec.Signature)
+		if err != nil {
+			return "", err
+		}
+		return r.FormatType(t), nil
+	case TableMethodDef:
+		method, err := r.MethodDef(token.Row())
+		if err != nil {
+			return "", err
+		}
+		owner, err := r.MethodOwner(token.Row())
+		if err != nil {
+			return "", err
+		}
+		return r.memberDisplayName(owner, method.Name)
+	case TableField:
+		field, err := r.Field(token.Row())
+		if err != nil {
+			return "", err
+		}
+		owner, err := r.FieldOwner(token.Row())
+		if err != nil {
+			return "", err
+		}
+		return r.memberDisplayName(owner, field.Name)
+	case TableMemberRef:
+		ref, err := r.MemberRef(token.Row())
+		if err != nil {
+			return "", err
+		}
+		return r.memberDisplayName(ref.Class, ref.Name)
+	case TableMethodSpec:
+		spec, err := r.MethodSpec(token.Row())
+		if err != nil {
+			return "", err
+		}
+		name, err := r.DisplayName(spec.Method)
+		if err != nil {
+			return "", err
+		}
+		args, err := ParseMethodSpecSignature(spec.Instantiation)
+		if err != nil {
+			return "", err
+		}
+		return name + r.formatTypeArgs(args), nil
+	case TableModuleRef:
+		ref, err := r.ModuleRef(token.Row())
+		if err != nil {
+			return "", err
+		}
+		return ref.Name, nil
+	default:
+		return "", fmt.Errorf("the token %s does not refer to a type or member", token)
+	}
+}
+
+// memberDisplayName returns the display name of a member with the given
+// name that belongs to the type or module with the given token.
+func (r *Reader) memberDisplayName(owner Token, name string) (string, error) {
+	if owner.IsNil() {
+		return name, nil
+	}
+	if owner.Table() == TableMethodDef {
+		// A vararg call site refers to the method definition that it
+		// calls.
+		return r.DisplayName(owner)
+	}
+	ownerName, err := r.DisplayName(owner)
+	if err != nil {
+		return "", err
+	}
+	return ownerName + "::" + name, nil
+}
+
+// typeDisplayName returns the display name of the type with the given
+// TypeDef or TypeRef token, including the names of its enclosing types.
+func (r *Reader) typeDisplayName(token Token, depth int) (string, error) {
+	if depth > maxNestingDepth {
+		return "", fmt.Errorf("the type %s exceeds the maximum nesting depth of %d", token, maxNestingDepth)
+	}
+	name, err := r.TypeName(token)
+	if err != nil {
+		return "", err
+	}
+	var enclosing Token
+	switch token.Table() {
+	case TableTypeDef:
+		if enclosing, err = r.enclosingType(token.Row()); err != nil {
+			return "", err
+		}
+	case TableTypeRef:
+		ref, err := r.TypeRef(token.Row())
+		if err != nil {
+			return "", err
+		}
+		if ref.ResolutionScope.Table() == TableTypeRef {
+			enclosing = ref.ResolutionScope
+		}
+	}
+	if enclosing.IsNil() {
+		return name.String(), nil
+	}
+	outer, err := r.typeDisplayName(enclosing, depth+1)
+	if err != nil {
+		return "", err
+	}
+	return outer + "/" + name.String(), nil
+}
+
+// enclosingType returns the TypeDef token of the type that encloses the
+// type with the given TypeDef row. It returns a nil token if the type is
+// not nested.
+func (r *Reader) enclosingType(row uint32) (Token, error) {
+	// The NestedClass table is sorted by its NestedClass column.
+	count := r.RowCount(TableNestedClass)
+	var err error
+	index := sort.Search(int(count), func(i int) bool {
+		values, e := r.tables.row(TableNestedClass, uint32(i+1))
+		if e != nil {
+			err = e
+			return true
+		}
+		return values[0] >= row
+	})
+	if err != nil || index == int(count) {
+		return 0, err
+	}
+	nested, err := r.NestedClass(uint32(index + 1))
+	if err != nil || nested.NestedClass.Row() != row {
+		return 0, err
+	}
+	return nested.EnclosingClass, nil
+}
+
+// FormatType returns a human-readable representation of the given type,
+// in the style of IL disassemblers. Custom modifiers are omitted.
+func (r *Reader) FormatType(t SignatureType) string {
+	switch t.Element {
+	case ElementVoid:
+		return "void"
+	case ElementBoolean:
+		return "bool"
+	case ElementChar:
+		return "char"
+	case ElementInt8:
+		return "int8"
+	case ElementUint8:
+		return "uint8"
+	case ElementInt16:
+		return "int16"
+	case ElementUint16:
+		return "uint16"
+	case ElementInt32:
+		return "int32"
+	case ElementUint32:
+		return "uint32"
+	case ElementInt64:
+		return "int64"
+	case ElementUint64:
+		return "uint64"
+	case ElementFloat32:
+		return "float32"
+	case ElementFloat64:
+		return "float64"
+	case ElementString:
+		return "string"
+	case ElementObject:
+		return "object"
+	case ElementIntPtr:
+		return "native int"
+	case ElementUintPtr:
+		return "native uint"
+	case ElementTypedByRef:
+		return "typedref"
+	case ElementClass, ElementValueType:
+		// Signatures of constructed types are not followed, which
+		// prevents them from referring to themselves.
+		if t.Token.Table() == TableTypeSpec {
+			return t.Token.String()
+		}
+		name, err := r.DisplayName(t.Token)
+		if err != nil {
+			return t.Token.String()
+		}
+		return name
+	case ElementVar:
+		return fmt.Sprintf("!%d", t.Number)
+	case ElementMVar:
+		return fmt.Sprintf("!!%d", t.Number)
+	case ElementPtr:
+		return r.formatElem(t) + "*"
+	case ElementByRef:
+		return r.formatElem(t) + "&"
+	case ElementPinned:
+		return r.formatElem(t) + " pinned"
+	case ElementSZArray:
+		return r.formatElem(t) + "[]"
+	case ElementArray:
+		rank := max(int(t.Shape.Rank), 1)
+		return r.formatElem(t) + "[" + strings.Repeat(",", rank-1) + "]"
+	case ElementGenericInst:
+		return r.formatElem(t) + r.formatTypeArgs(t.Args)
+	case ElementFnPtr:
+		if t.Method == nil {
+			return "method"
+		}
+		params := make([]string, len(t.Method.Params))
+		for i := range t.Method.Params {
+			params[i] = r.FormatType(t.Method.Params[i])
+		}
+		return "method " + r.FormatType(t.Method.Return) + " *(" + strings.Join(params, ", ") + ")"
+	default:
+		return t.Element.String()
+	}
+}
+
+// formatElem formats the element type of a constructed type.
+func (r *Reader) formatElem(t SignatureType) string {
+	if t.Elem == nil {
+		return "?"
+	}
+	return r.FormatType(*t.Elem)
+}
+
+// formatTypeArgs formats a list of generic type arguments.
+func (r *Reader) formatTypeArgs(args []SignatureType) string {
+	names := make([]string, len(args))
+	for i := range args {
+		names[i] = r.FormatType(args[i])
+	}
+	return "<" + strings.Join(names, ", ") + ">"
+}
@@ -0,0 +1,138 @@
+package metadata
+
+import "fmt"
+
+// ElementType identifies the type of a value within a signature or a
+// custom attribute value.
+type ElementType uint8
+
+// Element types.
+//
+// ECMA-335 II.23.1.16
+const (
+	ElementEnd         ElementType = 0x00 // ELEMENT_TYPE_END
+	ElementVoid        ElementType = 0x01 // ELEMENT_TYPE_VOID
+	ElementBoolean     ElementType = 0x02 // ELEMENT_TYPE_BOOLEAN
+	ElementChar        ElementType = 0x03 // ELEMENT_TYPE_CHAR
+	ElementInt8        ElementType = 0x04 // ELEMENT_TYPE_I1
+	ElementUint8       ElementType = 0x05 // ELEMENT_TYPE_U1
+	ElementInt16       ElementType = 0x06 // ELEMENT_TYPE_I2
+	ElementUint16      ElementType = 0x07 // ELEMENT_TYPE_U2
+	ElementInt32       ElementType = 0x08 // ELEMENT_TYPE_I4
+	ElementUint32      ElementType = 0x09 // ELEMENT_TYPE_U4
+	ElementInt64       ElementType = 0x0A // ELEMENT_TYPE_I8
+	ElementUint64      ElementType = 0x0B // ELEMENT_TYPE_U8
+	ElementFloat32     ElementType = 0x0C // ELEMENT_TYPE_R4
+	ElementFloat64     ElementType = 0x0D // ELEMENT_TYPE_R8
+	ElementString      ElementType = 0x0E // ELEMENT_TYPE_STRING
+	ElementPtr         ElementType = 0x0F // ELEMENT_TYPE_PTR
+	ElementByRef       ElementType = 0x10 // ELEMENT_TYPE_BYREF
+	ElementValueType   ElementType = 0x11 // ELEMENT_TYPE_VALUETYPE
+	ElementClass       ElementType = 0x12 // ELEMENT_TYPE_CLASS
+	ElementVar         ElementType = 0x13 // ELEMENT_TYPE_VAR
+	ElementArray       ElementType = 0x14 // ELEMENT_TYPE_ARRAY
+	ElementGenericInst ElementType = 0x15 // ELEMENT_TYPE_GENERICINST
+	ElementTypedByRef  ElementType = 0x16 // ELEMENT_TYPE_TYPEDBYREF
+	ElementIntPtr      ElementType = 0x18 // ELEMENT_TYPE_I
+	ElementUintPtr     ElementType = 0x19 // ELEMENT_TYPE_U
+	ElementFnPtr       ElementType = 0x1B // ELEMENT_TYPE_FNPTR
+	ElementObject      ElementType = 0x1C // ELEMENT_TYPE_OBJECT
+	ElementSZArray     ElementType = 0x1D // ELEMENT_TYPE_SZARRAY
+	ElementMVar        ElementType = 0x1E // ELEMENT_TYPE_MVAR
+	ElementCModReqd    ElementType = 0x1F // ELEMENT_TYPE_CMOD_REQD
+	ElementCModOpt     ElementType = 0x20 // ELEMENT_TYPE_CMOD_OPT
+	ElementInternal    ElementType = 0x21 // ELEMENT_TYPE_INTERNAL
+	ElementSentinel    ElementType = 0x41 // ELEMENT_TYPE_SENTINEL
+	ElementPinned      ElementType = 0x45 // ELEMENT_TYPE_PINNED
+	ElementSystemType  ElementType = 0x50 // A System.Type value within a custom attribute
+	ElementBoxed       ElementType = 0x51 // A boxed value within a custom attribute
+	ElementField       ElementType = 0x53 // A field within a custom attribute
+	ElementProperty    ElementType = 0x54 // A property within a custom attribute
+	ElementEnum        ElementType = 0x55 // An enum value within a custom attribute
+)
+
+// String returns a string representation of the element type.
+func (t ElementType) String() string {
+	switch t {
+	case ElementEnd:
+		return "end"
+	case ElementVoid:
+		return "void"
+	case ElementBoolean:
+		return "bool"
+	case ElementChar:
+		return "char"
+	case ElementInt8:
+		return "int8"
+	case ElementUint8:
+		return "uint8"
+	case ElementInt16:
+		return "int16"
+	case ElementUint16:
+		return "uint16"
+	case ElementInt32:
+		return "int32"
+	case ElementUint32:
+		return "uint32"
+	case ElementInt64:
+		return "int64"
+	case ElementUint64:
+		return "uint64"
+	case ElementFloat32:
+		return "float32"
+	case ElementFloat64:
+		return "float64"
+	case ElementString:
+		return "string"
+	case ElementPtr:
+		return "ptr"
+	case ElementByRef:
+		return "byref"
+	case ElementValueType:
+		return "valuetype"
+	case ElementClass:
+		return "class"
+	case ElementVar:
+		return "var"
+	case ElementArray:
+		return "array"
+	case ElementGenericInst:
+		return "genericinst"
+	case ElementTypedByRef:
+		return "typedref"
+	case ElementIntPtr:
+		return "native int"
+	case ElementUintPtr:
+		return "native uint"
+	case ElementFnPtr:
+		return "fnptr"
+	case ElementObject:
+		return "object"
+	case ElementSZArray:
+		return "szarray"
+	case ElementMVar:
+		return "mvar"
+	case ElementCModReqd:
+		return "modreq"
+	case ElementCModOpt:
+		return "modopt"
+	case ElementInternal:
+		return "internal"
+	case ElementSentinel:
+		return "sentinel"
+	case ElementPinned:
+		return "pinned"
+	case ElementSystemType:
+		return "type"
+	case ElementBoxed:
+		return "boxed"
+	case ElementField:
+		return "field"
+	case ElementProperty:
+		return "property"
+	case ElementEnum:
+		return "enum"
+	default:
+		return fmt.Sprintf("<unrecognized element type: %x>", uint8(t))
+	}
+}
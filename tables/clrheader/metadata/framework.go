@@ -0,0 +1,59 @@
+package metadata
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMissingTargetFramework is returned by [Reader.TargetFramework] if
+// the assembly doesn't have a TargetFrameworkAttribute.
+var ErrMissingTargetFramework = errors.New("the assembly does not have a target framework attribute")
+
+// TargetFramework identifies the framework that an assembly was built
+// for, as recorded by its TargetFrameworkAttribute.
+type TargetFramework struct {
+	// Name is the framework name, such as ".NETCoreApp,Version=v8.0".
+	Name string
+
+	// DisplayName is the human-readable name of the framework, such as
+	// ".NET 8.0". It is empty if the attribute doesn't specify it.
+	DisplayName string
+}
+
+// targetFrameworkAttribute is the name of the TargetFrameworkAttribute
+// type.
+var targetFrameworkAttribute = TypeName{Namespace: "System.Runtime.Versioning", Name: "TargetFrameworkAttribute"}
+
+// TargetFramework returns the target framework recorded by the
+// assembly's TargetFrameworkAttribute. It returns
+// [ErrMissingTargetFramework] if the assembly doesn't have one.
+func (r *Reader) TargetFramework() (TargetFramework, error) {
+	attributes, err := r.CustomAttributes(NewToken(TableAssembly, 1))
+	if err != nil {
+		return TargetFramework{}, err
+	}
+	for _, attribute := range attributes {
+		name, err := r.AttributeType(attribute)
+		if err != nil {
+			return TargetFramework{}, err
+		}
+		if name != targetFrameworkAttribute {
+			continue
+		}
+		value, err := r.DecodeCustomAttribute(attribute, nil)
+		if err != nil {
+			return TargetFramework{}, fmt.Errorf("failed to decode the target framework attribute: %w", err)
+		}
+		var framework TargetFramework
+		if len(value.Fixed) > 0 {
+			framework.Name, _ = value.Fixed[0].Value.(string)
+		}
+		for _, arg := range value.Named {
+			if arg.Name == "FrameworkDisplayName" {
+				framework.DisplayName, _ = arg.Value.(string)
+			}
+		}
+		return framework, nil
+	}
+	return TargetFramework{}, ErrMissingTargetFramework
+}
@@ -0,0 +1,105 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// GUID is a globally unique identifier stored in the #GUID heap.
+type GUID [16]byte
+
+// String returns a string representation of the GUID in its registry
+// format.
+func (guid GUID) String() string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(guid[0:4]),
+		binary.LittleEndian.Uint16(guid[4:6]),
+		binary.LittleEndian.Uint16(guid[6:8]),
+		guid[8:10],
+		guid[10:16])
+}
+
+// heaps holds the contents of the metadata heaps.
+type heaps struct {
+	strings     []byte
+	userStrings []byte
+	guids       []byte
+	blobs       []byte
+}
+
+// String returns the null-terminated UTF-8 string at the given offset
+// within the #Strings heap.
+func (h *heaps) String(offset uint32) (string, error) {
+	if offset == 0 {
+		return "", nil
+	}
+	if uint(offset) >= uint(len(h.strings)) {
+		return "", fmt.Errorf("the string offset %d exceeds the %d byte length of the #Strings heap", offset, len(h.strings))
+	}
+	data := h.strings[offset:]
+	if cutoff := bytes.IndexByte(data, 0); cutoff >= 0 {
+		data = data[:cutoff]
+	}
+	return string(data), nil
+}
+
+// UserString returns the UTF-16 string at the given offset within the #US
+// heap. User strings are referenced by the ldstr instruction.
+func (h *heaps) UserString(offset uint32) (string, error) {
+	data, err := blobAt(h.userStrings, offset, StreamUserStrings)
+	if err != nil {
+		return "", err
+	}
+
+	// Each string is followed by a single byte that indicates whether it
+	// holds any special characters.
+	length := len(data) &^ 1
+	units := make([]uint16, length/2)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// GUID returns the GUID with the given index within the #GUID heap. The
+// index is 1-based. An index of zero returns a zeroed GUID.
+func (h *heaps) GUID(index uint32) (GUID, error) {
+	var guid GUID
+	if index == 0 {
+		return guid, nil
+	}
+	start := uint(index-1) * 16
+	if start+16 > uint(len(h.guids)) {
+		return guid, fmt.Errorf("the GUID index %d exceeds the %d byte length of the #GUID heap", index, len(h.guids))
+	}
+	copy(guid[:], h.guids[start:start+16])
+	return guid, nil
+}
+
+// Blob returns the blob at the given offset within the #Blob heap. The
+// returned slice refers to the metadata and must not be modified.
+func (h *heaps) Blob(offset uint32) ([]byte, error) {
+	return blobAt(h.blobs, offset, StreamBlob)
+}
+
+// blobAt returns the length-prefixed blob at the given offset within heap.
+func blobAt(heap []byte, offset uint32, name string) ([]byte, error) {
+	if offset == 0 && len(heap) == 0 {
+		return nil, nil
+	}
+	if uint(offset) >= uint(len(heap)) {
+		return nil, fmt.Errorf("the offset %d exceeds the %d byte length of the %s heap", offset, len(heap), name)
+	}
+	length, n, err := DecodeCompressedUint(heap[offset:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the length of the %s heap entry at offset %d: %w", name, offset, err)
+	}
+	start := uint(offset) + uint(n)
+	end := start + uint(length)
+	if end > uint(len(heap)) {
+		return nil, fmt.Errorf("the %s heap entry at offset %d has a length of %d bytes that exceeds the bounds of the heap", name, offset, length)
+	}
+	return heap[start:end:end], nil
+}
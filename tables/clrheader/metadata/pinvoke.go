@@ -0,0 +1,107 @@
+package metadata
+
+import "fmt"
+
+// PInvokeFlags holds flags that describe how a platform invoke method is
+// called.
+type PInvokeFlags uint16
+
+// Platform invoke flags.
+//
+// ECMA-335 II.23.1.8
+const (
+	PInvokeNoMangle          PInvokeFlags = 0x0001 // The entry point name is used as-is
+	PInvokeCharSetAnsi       PInvokeFlags = 0x0002 // Strings are marshaled as ANSI strings
+	PInvokeCharSetUnicode    PInvokeFlags = 0x0004 // Strings are marshaled as Unicode strings
+	PInvokeCharSetAuto       PInvokeFlags = 0x0006 // Strings are marshaled according to the platform
+	PInvokeSupportsLastError PInvokeFlags = 0x0040 // The last error is saved after the call
+	PInvokeCallConvWinAPI    PInvokeFlags = 0x0100 // The platform's default calling convention is used
+	PInvokeCallConvCdecl     PInvokeFlags = 0x0200 // The cdecl calling convention is used
+	PInvokeCallConvStdcall   PInvokeFlags = 0x0300 // The stdcall calling convention is used
+	PInvokeCallConvThiscall  PInvokeFlags = 0x0400 // The thiscall calling convention is used
+	PInvokeCallConvFastcall  PInvokeFlags = 0x0500 // The fastcall calling convention is used
+	pinvokeCharSetMask       PInvokeFlags = 0x0006
+	pinvokeCallConvMask      PInvokeFlags = 0x0700
+)
+
+// SupportsLastError returns true if the last error is saved after the
+// call.
+func (flags PInvokeFlags) SupportsLastError() bool {
+	return flags&PInvokeSupportsLastError != 0
+}
+
+// CharSet returns the name of the character set used to marshal strings.
+func (flags PInvokeFlags) CharSet() string {
+	switch flags & pinvokeCharSetMask {
+	case PInvokeCharSetAnsi:
+		return "Ansi"
+	case PInvokeCharSetUnicode:
+		return "Unicode"
+	case PInvokeCharSetAuto:
+		return "Auto"
+	default:
+		return "None"
+	}
+}
+
+// CallingConvention returns the name of the calling convention used to
+// call the native function.
+func (flags PInvokeFlags) CallingConvention() string {
+	switch conv := flags & pinvokeCallConvMask; conv {
+	case 0:
+		return ""
+	case PInvokeCallConvWinAPI:
+		return "Winapi"
+	case PInvokeCallConvCdecl:
+		return "Cdecl"
+	case PInvokeCallConvStdcall:
+		return "StdCall"
+	case PInvokeCallConvThiscall:
+		return "ThisCall"
+	case PInvokeCallConvFastcall:
+		return "FastCall"
+	default:
+		return fmt.Sprintf("<unrecognized calling convention: %x>", uint16(conv))
+	}
+}
+
+// PInvokeImport describes a native function that is called by a platform
+// invoke method.
+type PInvokeImport struct {
+	Module     string // The name of the native library, as it appears in the ModuleRef table
+	EntryPoint string // The name of the native function
+	Method     Token  // The MethodDef of the platform invoke method
+	MethodName string // The name of the platform invoke method
+	Flags      PInvokeFlags
+}
+
+// PInvokeImports returns the native functions that are called by the
+// platform invoke methods of the metadata.
+func (r *Reader) PInvokeImports() ([]PInvokeImport, error) {
+	maps, err := r.ImplMapTable()
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]PInvokeImport, 0, len(maps))
+	for _, entry := range maps {
+		module, err := r.ModuleRef(entry.ImportScope.Row())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the module of platform invoke method %s: %w", entry.MemberForwarded, err)
+		}
+		imp := PInvokeImport{
+			Module:     module.Name,
+			EntryPoint: entry.ImportName,
+			Method:     entry.MemberForwarded,
+			Flags:      entry.MappingFlags,
+		}
+		if entry.MemberForwarded.Table() == TableMethodDef {
+			method, err := r.MethodDef(entry.MemberForwarded.Row())
+			if err != nil {
+				return nil, fmt.Errorf("failed to read platform invoke method %s: %w", entry.MemberForwarded, err)
+			}
+			imp.MethodName = method.Name
+		}
+		imports = append(imports, imp)
+	}
+	return imports, nil
+}
@@ -0,0 +1,112 @@
+package metadata
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+)
+
+// Reader reads ECMA-335 metadata, which describes the types, members and
+// references of a managed module. The metadata is held in memory.
+type Reader struct {
+	heaps
+	data   []byte
+	root   Root
+	tables *tableStream
+
+	// typeDefs maps the serialized names of the types defined by the
+	// metadata to their TypeDef tokens. It is built on first use.
+	typeDefs     map[string]Token
+	typeDefsOnce sync.Once
+}
+
+// NewReader reads the metadata referenced by the CLR header that is read
+// by clr and returns a [Reader] for it. It returns
+// [clrheader.ErrMissingMetadata] if the CLR header doesn't reference any
+// metadata.
+func NewReader(clr *clrheader.Reader) (*Reader, error) {
+	header, err := clr.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+	if header.Metadata.IsZero() {
+		return nil, clrheader.ErrMissingMetadata
+	}
+	data, err := clr.ReadData(header.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the metadata: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses the given metadata, which starts with the metadata root,
+// and returns a [Reader] for it. It can be used to read metadata that
+// is stored outside of an image file, such as a portable PDB file.
+func Parse(data []byte) (*Reader, error) {
+	root, err := ParseRoot(data)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reader{
+		data: data,
+		root: root,
+	}
+
+	stream := func(name string) []byte {
+		header, ok := root.Stream(name)
+		if !ok {
+			return nil
+		}
+		return data[header.Offset : header.Offset+header.Size]
+	}
+
+	r.strings = stream(StreamStrings)
+	r.userStrings = stream(StreamUserStrings)
+	r.guids = stream(StreamGUID)
+	r.blobs = stream(StreamBlob)
+
+	tables := stream(StreamTables)
+	if tables == nil {
+		tables = stream(StreamUncompressedTables)
+	}
+	if tables == nil {
+		return nil, fmt.Errorf("the metadata does not have a tables stream")
+	}
+	if r.tables, err = parseTableStream(tables); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Root returns the metadata root.
+func (r *Reader) Root() Root {
+	return r.root
+}
+
+// Version returns the version string of the metadata root, which
+// identifies the version of the runtime that the metadata was built for.
+func (r *Reader) Version() string {
+	return r.root.Version
+}
+
+// TablesVersion returns the major and minor version of the tables stream.
+func (r *Reader) TablesVersion() (major, minor uint8) {
+	return r.tables.majorVersion, r.tables.minorVersion
+}
+
+// Tables returns information about each of the metadata tables that are
+// present.
+func (r *Reader) Tables() []TableInfo {
+	return r.tables.tables()
+}
+
+// RowCount returns the number of rows in the given table.
+func (r *Reader) RowCount(table TableID) uint32 {
+	if int(table) >= len(r.tables.rows) {
+		return 0
+	}
+	return r.tables.rows[table]
+}
@@ -0,0 +1,40 @@
+package metadata
+
+import "fmt"
+
+// ResourceFlags holds flags that describe a manifest resource.
+type ResourceFlags uint32
+
+// Manifest resource flags.
+//
+// ECMA-335 II.23.1.9
+const (
+	ResourcePublic         ResourceFlags = 0x0001 // The resource is exported from the assembly
+	ResourcePrivate        ResourceFlags = 0x0002 // The resource is private to the assembly
+	resourceVisibilityMask ResourceFlags = 0x0007
+)
+
+// Public returns true if the resource is exported from the assembly.
+func (flags ResourceFlags) Public() bool {
+	return flags&resourceVisibilityMask == ResourcePublic
+}
+
+// String returns a string representation of the resource's visibility.
+func (flags ResourceFlags) String() string {
+	switch flags & resourceVisibilityMask {
+	case ResourcePublic:
+		return "public"
+	case ResourcePrivate:
+		return "private"
+	default:
+		return fmt.Sprintf("<unrecognized resource visibility: %x>", uint32(flags&resourceVisibilityMask))
+	}
+}
+
+// Embedded returns true if the resource is embedded within the CLR
+// resources of the module, rather than being held by another file or
+// assembly. The data of an embedded resource can be read with
+// [clrheader.Reader.ReadResource].
+func (resource ManifestResource) Embedded() bool {
+	return resource.Implementation.IsNil()
+}
@@ -0,0 +1,119 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Signature is the signature at the start of the metadata root, which
+// spells "BSJB".
+const Signature = 0x424A5342
+
+// Root is the metadata root, which describes the version of the metadata
+// and the streams that it contains.
+type Root struct {
+	MajorVersion uint16
+	MinorVersion uint16
+
+	// Version identifies the version of the runtime that the metadata was
+	// built for, such as "v4.0.30319".
+	Version string
+
+	Flags   uint16
+	Streams []StreamHeader
+}
+
+// Stream returns the header of the first stream with the given name. It
+// returns false if the metadata doesn't have a stream with that name.
+func (root Root) Stream(name string) (StreamHeader, bool) {
+	for _, stream := range root.Streams {
+		if stream.Name == name {
+			return stream, true
+		}
+	}
+	return StreamHeader{}, false
+}
+
+// StreamHeader describes the location of a stream within the metadata.
+type StreamHeader struct {
+	Name   string
+	Offset uint32 // The offset of the stream from the start of the metadata root
+	Size   uint32
+}
+
+// Well-known stream names.
+const (
+	StreamTables             = "#~"       // Compressed metadata tables
+	StreamUncompressedTables = "#-"       // Uncompressed metadata tables, which may include edit and continue data
+	StreamStrings            = "#Strings" // Identifier strings
+	StreamUserStrings        = "#US"      // User strings
+	StreamGUID               = "#GUID"    // GUIDs
+	StreamBlob               = "#Blob"    // Signatures and other binary data
+	StreamPDB                = "#Pdb"     // Portable PDB information
+)
+
+// ParseRoot parses the metadata root at the start of data.
+func ParseRoot(data []byte) (Root, error) {
+	if len(data) < 16 {
+		return Root{}, fmt.Errorf("the metadata root is %d byte(s) long when at least 16 bytes are required", len(data))
+	}
+	if signature := binary.LittleEndian.Uint32(data[0:4]); signature != Signature {
+		return Root{}, fmt.Errorf("the metadata root does not have the expected signature")
+	}
+
+	root := Root{
+		MajorVersion: binary.LittleEndian.Uint16(data[4:6]),
+		MinorVersion: binary.LittleEndian.Uint16(data[6:8]),
+	}
+
+	// The version string is padded to a multiple of 4 bytes.
+	length := uint(binary.LittleEndian.Uint32(data[12:16]))
+	offset := 16 + length
+	if length > 255 || offset+4 > uint(len(data)) {
+		return Root{}, fmt.Errorf("the metadata root has a version string with an invalid length of %d byte(s)", length)
+	}
+	version := data[16:offset]
+	if cutoff := bytes.IndexByte(version, 0); cutoff >= 0 {
+		version = version[:cutoff]
+	}
+	root.Version = string(version)
+	offset = align4(offset)
+	if offset+4 > uint(len(data)) {
+		return Root{}, fmt.Errorf("the metadata root is truncated")
+	}
+
+	root.Flags = binary.LittleEndian.Uint16(data[offset : offset+2])
+	count := int(binary.LittleEndian.Uint16(data[offset+2 : offset+4]))
+	offset += 4
+
+	// Each stream header holds an offset, a size and a null-terminated
+	// name that is padded to a multiple of 4 bytes.
+	for i := range count {
+		if offset+8 > uint(len(data)) {
+			return Root{}, fmt.Errorf("the metadata root is truncated within stream header %d", i)
+		}
+		stream := StreamHeader{
+			Offset: binary.LittleEndian.Uint32(data[offset : offset+4]),
+			Size:   binary.LittleEndian.Uint32(data[offset+4 : offset+8]),
+		}
+		offset += 8
+		name := data[offset:min(offset+32, uint(len(data)))]
+		cutoff := bytes.IndexByte(name, 0)
+		if cutoff < 0 {
+			return Root{}, fmt.Errorf("the metadata root has an unterminated name in stream header %d", i)
+		}
+		stream.Name = string(name[:cutoff])
+		offset = align4(offset + uint(cutoff) + 1)
+		if uint64(stream.Offset)+uint64(stream.Size) > uint64(len(data)) {
+			return Root{}, fmt.Errorf("the metadata stream \"%s\" exceeds the bounds of the metadata", stream.Name)
+		}
+		root.Streams = append(root.Streams, stream)
+	}
+
+	return root, nil
+}
+
+func align4(value uint) uint {
+	return (value + 3) &^ 3
+}
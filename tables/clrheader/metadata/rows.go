@@ -0,0 +1,1203 @@
+package metadata
+
+import "github.com/gentlemanautomaton/portableexecutable/imagefile"
+
+// Module is a row of the Module table, which describes the module that the
+// metadata belongs to.
+type Module struct {
+	Generation uint16
+	Name       string
+	MVID       GUID // The module version identifier, which is unique to each build
+	EncID      GUID
+	EncBaseID  GUID
+}
+
+func decodeModule(d *rowDecoder, v [maxColumns]uint32) Module {
+	return Module{
+		Generation: uint16(v[0]),
+		Name:       d.string(v[1]),
+		MVID:       d.guid(v[2]),
+		EncID:      d.guid(v[3]),
+		EncBaseID:  d.guid(v[4]),
+	}
+}
+
+// ModuleTable returns all of the rows in the Module table.
+func (r *Reader) ModuleTable() ([]Module, error) {
+	return readRows(r, TableModule, decodeModule)
+}
+
+// Module returns the row of the Module table with the given 1-based row
+// number.
+func (r *Reader) Module(row uint32) (Module, error) {
+	return readRow(r, TableModule, row, decodeModule)
+}
+
+// TypeRef is a row of the TypeRef table, which refers to a type that is
+// defined in another module or assembly.
+type TypeRef struct {
+	ResolutionScope Token // The Module, ModuleRef, AssemblyRef or enclosing TypeRef that defines the type
+	Name            string
+	Namespace       string
+}
+
+func decodeTypeRef(d *rowDecoder, v [maxColumns]uint32) TypeRef {
+	return TypeRef{
+		ResolutionScope: d.coded(&resolutionScope, v[0]),
+		Name:            d.string(v[1]),
+		Namespace:       d.string(v[2]),
+	}
+}
+
+// TypeRefTable returns all of the rows in the TypeRef table.
+func (r *Reader) TypeRefTable() ([]TypeRef, error) {
+	return readRows(r, TableTypeRef, decodeTypeRef)
+}
+
+// TypeRef returns the row of the TypeRef table with the given 1-based row
+// number.
+func (r *Reader) TypeRef(row uint32) (TypeRef, error) {
+	return readRow(r, TableTypeRef, row, decodeTypeRef)
+}
+
+// TypeDef is a row of the TypeDef table, which defines a type within the
+// module.
+type TypeDef struct {
+	Flags      uint32
+	Name       string
+	Namespace  string
+	Extends    Token  // The TypeDef, TypeRef or TypeSpec of the base type
+	FieldList  uint32 // The first row of the type's fields in the Field table
+	MethodList uint32 // The first row of the type's methods in the MethodDef table
+}
+
+func decodeTypeDef(d *rowDecoder, v [maxColumns]uint32) TypeDef {
+	return TypeDef{
+		Flags:      v[0],
+		Name:       d.string(v[1]),
+		Namespace:  d.string(v[2]),
+		Extends:    d.coded(&typeDefOrRef, v[3]),
+		FieldList:  v[4],
+		MethodList: v[5],
+	}
+}
+
+// TypeDefTable returns all of the rows in the TypeDef table.
+func (r *Reader) TypeDefTable() ([]TypeDef, error) {
+	return readRows(r, TableTypeDef, decodeTypeDef)
+}
+
+// TypeDef returns the row of the TypeDef table with the given 1-based row
+// number.
+func (r *Reader) TypeDef(row uint32) (TypeDef, error) {
+	return readRow(r, TableTypeDef, row, decodeTypeDef)
+}
+
+// FieldPtr is a row of the FieldPtr table, which is an entry in the
+// indirection table for fields, which is only present in uncompressed
+// metadata.
+type FieldPtr struct {
+	Field Token
+}
+
+func decodeFieldPtr(d *rowDecoder, v [maxColumns]uint32) FieldPtr {
+	return FieldPtr{
+		Field: NewToken(TableField, v[0]),
+	}
+}
+
+// FieldPtrTable returns all of the rows in the FieldPtr table.
+func (r *Reader) FieldPtrTable() ([]FieldPtr, error) {
+	return readRows(r, TableFieldPtr, decodeFieldPtr)
+}
+
+// FieldPtr returns the row of the FieldPtr table with the given 1-based row
+// number.
+func (r *Reader) FieldPtr(row uint32) (FieldPtr, error) {
+	return readRow(r, TableFieldPtr, row, decodeFieldPtr)
+}
+
+// Field is a row of the Field table, which defines a field of a type.
+type Field struct {
+	Flags     uint16
+	Name      string
+	Signature []byte
+}
+
+func decodeField(d *rowDecoder, v [maxColumns]uint32) Field {
+	return Field{
+		Flags:     uint16(v[0]),
+		Name:      d.string(v[1]),
+		Signature: d.blob(v[2]),
+	}
+}
+
+// FieldTable returns all of the rows in the Field table.
+func (r *Reader) FieldTable() ([]Field, error) {
+	return readRows(r, TableField, decodeField)
+}
+
+// Field returns the row of the Field table with the given 1-based row
+// number.
+func (r *Reader) Field(row uint32) (Field, error) {
+	return readRow(r, TableField, row, decodeField)
+}
+
+// MethodPtr is a row of the MethodPtr table, which is an entry in the
+// indirection table for methods, which is only present in uncompressed
+// metadata.
+type MethodPtr struct {
+	Method Token
+}
+
+func decodeMethodPtr(d *rowDecoder, v [maxColumns]uint32) MethodPtr {
+	return MethodPtr{
+		Method: NewToken(TableMethodDef, v[0]),
+	}
+}
+
+// MethodPtrTable returns all of the rows in the MethodPtr table.
+func (r *Reader) MethodPtrTable() ([]MethodPtr, error) {
+	return readRows(r, TableMethodPtr, decodeMethodPtr)
+}
+
+// MethodPtr returns the row of the MethodPtr table with the given 1-based
+// row number.
+func (r *Reader) MethodPtr(row uint32) (MethodPtr, error) {
+	return readRow(r, TableMethodPtr, row, decodeMethodPtr)
+}
+
+// MethodDef is a row of the MethodDef table, which defines a method of a
+// type.
+type MethodDef struct {
+	RVA       imagefile.RelativeVirtualAddress // The address of the method body, or zero if it doesn't have one
+	ImplFlags uint16
+	Flags     uint16
+	Name      string
+	Signature []byte
+	ParamList uint32 // The first row of the method's parameters in the Param table
+}
+
+func decodeMethodDef(d *rowDecoder, v [maxColumns]uint32) MethodDef {
+	return MethodDef{
+		RVA:       imagefile.RelativeVirtualAddress(v[0]),
+		ImplFlags: uint16(v[1]),
+		Flags:     uint16(v[2]),
+		Name:      d.string(v[3]),
+		Signature: d.blob(v[4]),
+		ParamList: v[5],
+	}
+}
+
+// MethodDefTable returns all of the rows in the MethodDef table.
+func (r *Reader) MethodDefTable() ([]MethodDef, error) {
+	return readRows(r, TableMethodDef, decodeMethodDef)
+}
+
+// MethodDef returns the row of the MethodDef table with the given 1-based
+// row number.
+func (r *Reader) MethodDef(row uint32) (MethodDef, error) {
+	return readRow(r, TableMethodDef, row, decodeMethodDef)
+}
+
+// ParamPtr is a row of the ParamPtr table, which is an entry in the
+// indirection table for parameters, which is only present in uncompressed
+// metadata.
+type ParamPtr struct {
+	Param Token
+}
+
+func decodeParamPtr(d *rowDecoder, v [maxColumns]uint32) ParamPtr {
+	return ParamPtr{
+		Param: NewToken(TableParam, v[0]),
+	}
+}
+
+// ParamPtrTable returns all of the rows in the ParamPtr table.
+func (r *Reader) ParamPtrTable() ([]ParamPtr, error) {
+	return readRows(r, TableParamPtr, decodeParamPtr)
+}
+
+// ParamPtr returns the row of the ParamPtr table with the given 1-based row
+// number.
+func (r *Reader) ParamPtr(row uint32) (ParamPtr, error) {
+	return readRow(r, TableParamPtr, row, decodeParamPtr)
+}
+
+// Param is a row of the Param table, which describes a parameter of a
+// method.
+type Param struct {
+	Flags    uint16
+	Sequence uint16 // The position of the parameter, with zero referring to the return value
+	Name     string
+}
+
+func decodeParam(d *rowDecoder, v [maxColumns]uint32) Param {
+	return Param{
+		Flags:    uint16(v[0]),
+		Sequence: uint16(v[1]),
+		Name:     d.string(v[2]),
+	}
+}
+
+// ParamTable returns all of the rows in the Param table.
+func (r *Reader) ParamTable() ([]Param, error) {
+	return readRows(r, TableParam, decodeParam)
+}
+
+// Param returns the row of the Param table with the given 1-based row
+// number.
+func (r *Reader) Param(row uint32) (Param, error) {
+	return readRow(r, TableParam, row, decodeParam)
+}
+
+// InterfaceImpl is a row of the InterfaceImpl table, which records an
+// interface that is implemented by a type.
+type InterfaceImpl struct {
+	Class     Token
+	Interface Token
+}
+
+func decodeInterfaceImpl(d *rowDecoder, v [maxColumns]uint32) InterfaceImpl {
+	return InterfaceImpl{
+		Class:     NewToken(TableTypeDef, v[0]),
+		Interface: d.coded(&typeDefOrRef, v[1]),
+	}
+}
+
+// InterfaceImplTable returns all of the rows in the InterfaceImpl table.
+func (r *Reader) InterfaceImplTable() ([]InterfaceImpl, error) {
+	return readRows(r, TableInterfaceImpl, decodeInterfaceImpl)
+}
+
+// InterfaceImpl returns the row of the InterfaceImpl table with the given
+// 1-based row number.
+func (r *Reader) InterfaceImpl(row uint32) (InterfaceImpl, error) {
+	return readRow(r, TableInterfaceImpl, row, decodeInterfaceImpl)
+}
+
+// MemberRef is a row of the MemberRef table, which refers to a field or
+// method of a type.
+type MemberRef struct {
+	Class     Token // The TypeDef, TypeRef, ModuleRef, MethodDef or TypeSpec that holds the member
+	Name      string
+	Signature []byte
+}
+
+func decodeMemberRef(d *rowDecoder, v [maxColumns]uint32) MemberRef {
+	return MemberRef{
+		Class:     d.coded(&memberRefParent, v[0]),
+		Name:      d.string(v[1]),
+		Signature: d.blob(v[2]),
+	}
+}
+
+// MemberRefTable returns all of the rows in the MemberRef table.
+func (r *Reader) MemberRefTable() ([]MemberRef, error) {
+	return readRows(r, TableMemberRef, decodeMemberRef)
+}
+
+// MemberRef returns the row of the MemberRef table with the given 1-based
+// row number.
+func (r *Reader) MemberRef(row uint32) (MemberRef, error) {
+	return readRow(r, TableMemberRef, row, decodeMemberRef)
+}
+
+// Constant is a row of the Constant table, which holds the constant value of
+// a field, parameter or property.
+type Constant struct {
+	Type   ElementType // The element type of the value
+	Parent Token
+	Value  []byte
+}
+
+func decodeConstant(d *rowDecoder, v [maxColumns]uint32) Constant {
+	return Constant{
+		Type:   ElementType(v[0]),
+		Parent: d.coded(&hasConstant, v[2]),
+		Value:  d.blob(v[3]),
+	}
+}
+
+// ConstantTable returns all of the rows in the Constant table.
+func (r *Reader) ConstantTable() ([]Constant, error) {
+	return readRows(r, TableConstant, decodeConstant)
+}
+
+// Constant returns the row of the Constant table with the given 1-based row
+// number.
+func (r *Reader) Constant(row uint32) (Constant, error) {
+	return readRow(r, TableConstant, row, decodeConstant)
+}
+
+// CustomAttribute is a row of the CustomAttribute table, which applies a
+// custom attribute to a metadata item.
+type CustomAttribute struct {
+	Parent Token // The item that the attribute is applied to
+	Type   Token // The MethodDef or MemberRef of the attribute's constructor
+	Value  []byte
+}
+
+func decodeCustomAttribute(d *rowDecoder, v [maxColumns]uint32) CustomAttribute {
+	return CustomAttribute{
+		Parent: d.coded(&hasCustomAttribute, v[0]),
+		Type:   d.coded(&customAttributeType, v[1]),
+		Value:  d.blob(v[2]),
+	}
+}
+
+// CustomAttributeTable returns all of the rows in the CustomAttribute table.
+func (r *Reader) CustomAttributeTable() ([]CustomAttribute, error) {
+	return readRows(r, TableCustomAttribute, decodeCustomAttribute)
+}
+
+// CustomAttribute returns the row of the CustomAttribute table with the
+// given 1-based row number.
+func (r *Reader) CustomAttribute(row uint32) (CustomAttribute, error) {
+	return readRow(r, TableCustomAttribute, row, decodeCustomAttribute)
+}
+
+// FieldMarshal is a row of the FieldMarshal table, which describes how a
+// field or parameter is marshaled to unmanaged code.
+type FieldMarshal struct {
+	Parent     Token
+	NativeType []byte
+}
+
+func decodeFieldMarshal(d *rowDecoder, v [maxColumns]uint32) FieldMarshal {
+	return FieldMarshal{
+		Parent:     d.coded(&hasFieldMarshal, v[0]),
+		NativeType: d.blob(v[1]),
+	}
+}
+
+// FieldMarshalTable returns all of the rows in the FieldMarshal table.
+func (r *Reader) FieldMarshalTable() ([]FieldMarshal, error) {
+	return readRows(r, TableFieldMarshal, decodeFieldMarshal)
+}
+
+// FieldMarshal returns the row of the FieldMarshal table with the given
+// 1-based row number.
+func (r *Reader) FieldMarshal(row uint32) (FieldMarshal, error) {
+	return readRow(r, TableFieldMarshal, row, decodeFieldMarshal)
+}
+
+// DeclSecurity is a row of the DeclSecurity table, which attaches a
+// declarative security permission set to a type, method or assembly.
+type DeclSecurity struct {
+	Action        uint16
+	Parent        Token
+	PermissionSet []byte
+}
+
+func decodeDeclSecurity(d *rowDecoder, v [maxColumns]uint32) DeclSecurity {
+	return DeclSecurity{
+		Action:        uint16(v[0]),
+		Parent:        d.coded(&hasDeclSecurity, v[1]),
+		PermissionSet: d.blob(v[2]),
+	}
+}
+
+// DeclSecurityTable returns all of the rows in the DeclSecurity table.
+func (r *Reader) DeclSecurityTable() ([]DeclSecurity, error) {
+	return readRows(r, TableDeclSecurity, decodeDeclSecurity)
+}
+
+// DeclSecurity returns the row of the DeclSecurity table with the given
+// 1-based row number.
+func (r *Reader) DeclSecurity(row uint32) (DeclSecurity, error) {
+	return readRow(r, TableDeclSecurity, row, decodeDeclSecurity)
+}
+
+// ClassLayout is a row of the ClassLayout table, which specifies the layout
+// of a type.
+type ClassLayout struct {
+	PackingSize uint16
+	ClassSize   uint32
+	Parent      Token
+}
+
+func decodeClassLayout(d *rowDecoder, v [maxColumns]uint32) ClassLayout {
+	return ClassLayout{
+		PackingSize: uint16(v[0]),
+		ClassSize:   v[1],
+		Parent:      NewToken(TableTypeDef, v[2]),
+	}
+}
+
+// ClassLayoutTable returns all of the rows in the ClassLayout table.
+func (r *Reader) ClassLayoutTable() ([]ClassLayout, error) {
+	return readRows(r, TableClassLayout, decodeClassLayout)
+}
+
+// ClassLayout returns the row of the ClassLayout table with the given
+// 1-based row number.
+func (r *Reader) ClassLayout(row uint32) (ClassLayout, error) {
+	return readRow(r, TableClassLayout, row, decodeClassLayout)
+}
+
+// FieldLayout is a row of the FieldLayout table, which specifies the offset
+// of a field within an explicitly laid out type.
+type FieldLayout struct {
+	Offset uint32
+	Field  Token
+}
+
+func decodeFieldLayout(d *rowDecoder, v [maxColumns]uint32) FieldLayout {
+	return FieldLayout{
+		Offset: v[0],
+		Field:  NewToken(TableField, v[1]),
+	}
+}
+
+// FieldLayoutTable returns all of the rows in the FieldLayout table.
+func (r *Reader) FieldLayoutTable() ([]FieldLayout, error) {
+	return readRows(r, TableFieldLayout, decodeFieldLayout)
+}
+
+// FieldLayout returns the row of the FieldLayout table with the given
+// 1-based row number.
+func (r *Reader) FieldLayout(row uint32) (FieldLayout, error) {
+	return readRow(r, TableFieldLayout, row, decodeFieldLayout)
+}
+
+// StandAloneSig is a row of the StandAloneSig table, which holds a signature
+// that isn't attached to a member, such as the signature of a method's local
+// variables.
+type StandAloneSig struct {
+	Signature []byte
+}
+
+func decodeStandAloneSig(d *rowDecoder, v [maxColumns]uint32) StandAloneSig {
+	return StandAloneSig{
+		Signature: d.blob(v[0]),
+	}
+}
+
+// StandAloneSigTable returns all of the rows in the StandAloneSig table.
+func (r *Reader) StandAloneSigTable() ([]StandAloneSig, error) {
+	return readRows(r, TableStandAloneSig, decodeStandAloneSig)
+}
+
+// StandAloneSig returns the row of the StandAloneSig table with the given
+// 1-based row number.
+func (r *Reader) StandAloneSig(row uint32) (StandAloneSig, error) {
+	return readRow(r, TableStandAloneSig, row, decodeStandAloneSig)
+}
+
+// EventMap is a row of the EventMap table, which maps a type to its events.
+type EventMap struct {
+	Parent    Token
+	EventList uint32 // The first row of the type's events in the Event table
+}
+
+func decodeEventMap(d *rowDecoder, v [maxColumns]uint32) EventMap {
+	return EventMap{
+		Parent:    NewToken(TableTypeDef, v[0]),
+		EventList: v[1],
+	}
+}
+
+// EventMapTable returns all of the rows in the EventMap table.
+func (r *Reader) EventMapTable() ([]EventMap, error) {
+	return readRows(r, TableEventMap, decodeEventMap)
+}
+
+// EventMap returns the row of the EventMap table with the given 1-based row
+// number.
+func (r *Reader) EventMap(row uint32) (EventMap, error) {
+	return readRow(r, TableEventMap, row, decodeEventMap)
+}
+
+// EventPtr is a row of the EventPtr table, which is an entry in the
+// indirection table for events, which is only present in uncompressed
+// metadata.
+type EventPtr struct {
+	Event Token
+}
+
+func decodeEventPtr(d *rowDecoder, v [maxColumns]uint32) EventPtr {
+	return EventPtr{
+		Event: NewToken(TableEvent, v[0]),
+	}
+}
+
+// EventPtrTable returns all of the rows in the EventPtr table.
+func (r *Reader) EventPtrTable() ([]EventPtr, error) {
+	return readRows(r, TableEventPtr, decodeEventPtr)
+}
+
+// EventPtr returns the row of the EventPtr table with the given 1-based row
+// number.
+func (r *Reader) EventPtr(row uint32) (EventPtr, error) {
+	return readRow(r, TableEventPtr, row, decodeEventPtr)
+}
+
+// Event is a row of the Event table, which defines an event of a type.
+type Event struct {
+	Flags     uint16
+	Name      string
+	EventType Token
+}
+
+func decodeEvent(d *rowDecoder, v [maxColumns]uint32) Event {
+	return Event{
+		Flags:     uint16(v[0]),
+		Name:      d.string(v[1]),
+		EventType: d.coded(&typeDefOrRef, v[2]),
+	}
+}
+
+// EventTable returns all of the rows in the Event table.
+func (r *Reader) EventTable() ([]Event, error) {
+	return readRows(r, TableEvent, decodeEvent)
+}
+
+// Event returns the row of the Event table with the given 1-based row
+// number.
+func (r *Reader) Event(row uint32) (Event, error) {
+	return readRow(r, TableEvent, row, decodeEvent)
+}
+
+// PropertyMap is a row of the PropertyMap table, which maps a type to its
+// properties.
+type PropertyMap struct {
+	Parent       Token
+	PropertyList uint32 // The first row of the type's properties in the Property table
+}
+
+func decodePropertyMap(d *rowDecoder, v [maxColumns]uint32) PropertyMap {
+	return PropertyMap{
+		Parent:       NewToken(TableTypeDef, v[0]),
+		PropertyList: v[1],
+	}
+}
+
+// PropertyMapTable returns all of the rows in the PropertyMap table.
+func (r *Reader) PropertyMapTable() ([]PropertyMap, error) {
+	return readRows(r, TablePropertyMap, decodePropertyMap)
+}
+
+// PropertyMap returns the row of the PropertyMap table with the given
+// 1-based row number.
+func (r *Reader) PropertyMap(row uint32) (PropertyMap, error) {
+	return readRow(r, TablePropertyMap, row, decodePropertyMap)
+}
+
+// PropertyPtr is a row of the PropertyPtr table, which is an entry in the
+// indirection table for properties, which is only present in uncompressed
+// metadata.
+type PropertyPtr struct {
+	Property Token
+}
+
+func decodePropertyPtr(d *rowDecoder, v [maxColumns]uint32) PropertyPtr {
+	return PropertyPtr{
+		Property: NewToken(TableProperty, v[0]),
+	}
+}
+
+// PropertyPtrTable returns all of the rows in the PropertyPtr table.
+func (r *Reader) PropertyPtrTable() ([]PropertyPtr, error) {
+	return readRows(r, TablePropertyPtr, decodePropertyPtr)
+}
+
+// PropertyPtr returns the row of the PropertyPtr table with the given
+// 1-based row number.
+func (r *Reader) PropertyPtr(row uint32) (PropertyPtr, error) {
+	return readRow(r, TablePropertyPtr, row, decodePropertyPtr)
+}
+
+// Property is a row of the Property table, which defines a property of a
+// type.
+type Property struct {
+	Flags     uint16
+	Name      string
+	Signature []byte
+}
+
+func decodeProperty(d *rowDecoder, v [maxColumns]uint32) Property {
+	return Property{
+		Flags:     uint16(v[0]),
+		Name:      d.string(v[1]),
+		Signature: d.blob(v[2]),
+	}
+}
+
+// PropertyTable returns all of the rows in the Property table.
+func (r *Reader) PropertyTable() ([]Property, error) {
+	return readRows(r, TableProperty, decodeProperty)
+}
+
+// Property returns the row of the Property table with the given 1-based row
+// number.
+func (r *Reader) Property(row uint32) (Property, error) {
+	return readRow(r, TableProperty, row, decodeProperty)
+}
+
+// MethodSemantics is a row of the MethodSemantics table, which associates a
+// method with an event or property.
+type MethodSemantics struct {
+	Semantics   uint16
+	Method      Token
+	Association Token
+}
+
+func decodeMethodSemantics(d *rowDecoder, v [maxColumns]uint32) MethodSemantics {
+	return MethodSemantics{
+		Semantics:   uint16(v[0]),
+		Method:      NewToken(TableMethodDef, v[1]),
+		Association: d.coded(&hasSemantics, v[2]),
+	}
+}
+
+// MethodSemanticsTable returns all of the rows in the MethodSemantics table.
+func (r *Reader) MethodSemanticsTable() ([]MethodSemantics, error) {
+	return readRows(r, TableMethodSemantics, decodeMethodSemantics)
+}
+
+// MethodSemantics returns the row of the MethodSemantics table with the
+// given 1-based row number.
+func (r *Reader) MethodSemantics(row uint32) (MethodSemantics, error) {
+	return readRow(r, TableMethodSemantics, row, decodeMethodSemantics)
+}
+
+// MethodImpl is a row of the MethodImpl table, which records a method body
+// that implements a method declaration.
+type MethodImpl struct {
+	Class             Token
+	MethodBody        Token
+	MethodDeclaration Token
+}
+
+func decodeMethodImpl(d *rowDecoder, v [maxColumns]uint32) MethodImpl {
+	return MethodImpl{
+		Class:             NewToken(TableTypeDef, v[0]),
+		MethodBody:        d.coded(&methodDefOrRef, v[1]),
+		MethodDeclaration: d.coded(&methodDefOrRef, v[2]),
+	}
+}
+
+// MethodImplTable returns all of the rows in the MethodImpl table.
+func (r *Reader) MethodImplTable() ([]MethodImpl, error) {
+	return readRows(r, TableMethodImpl, decodeMethodImpl)
+}
+
+// MethodImpl returns the row of the MethodImpl table with the given 1-based
+// row number.
+func (r *Reader) MethodImpl(row uint32) (MethodImpl, error) {
+	return readRow(r, TableMethodImpl, row, decodeMethodImpl)
+}
+
+// ModuleRef is a row of the ModuleRef table, which refers to another module.
+type ModuleRef struct {
+	Name string
+}
+
+func decodeModuleRef(d *rowDecoder, v [maxColumns]uint32) ModuleRef {
+	return ModuleRef{
+		Name: d.string(v[0]),
+	}
+}
+
+// ModuleRefTable returns all of the rows in the ModuleRef table.
+func (r *Reader) ModuleRefTable() ([]ModuleRef, error) {
+	return readRows(r, TableModuleRef, decodeModuleRef)
+}
+
+// ModuleRef returns the row of the ModuleRef table with the given 1-based
+// row number.
+func (r *Reader) ModuleRef(row uint32) (ModuleRef, error) {
+	return readRow(r, TableModuleRef, row, decodeModuleRef)
+}
+
+// TypeSpec is a row of the TypeSpec table, which describes a constructed
+// type, such as a generic instantiation or an array.
+type TypeSpec struct {
+	Signature []byte
+}
+
+func decodeTypeSpec(d *rowDecoder, v [maxColumns]uint32) TypeSpec {
+	return TypeSpec{
+		Signature: d.blob(v[0]),
+	}
+}
+
+// TypeSpecTable returns all of the rows in the TypeSpec table.
+func (r *Reader) TypeSpecTable() ([]TypeSpec, error) {
+	return readRows(r, TableTypeSpec, decodeTypeSpec)
+}
+
+// TypeSpec returns the row of the TypeSpec table with the given 1-based row
+// number.
+func (r *Reader) TypeSpec(row uint32) (TypeSpec, error) {
+	return readRow(r, TableTypeSpec, row, decodeTypeSpec)
+}
+
+// ImplMap is a row of the ImplMap table, which describes a platform invoke
+// method that is implemented by a native library.
+type ImplMap struct {
+	MappingFlags    PInvokeFlags
+	MemberForwarded Token
+	ImportName      string
+	ImportScope     Token // The ModuleRef of the native library
+}
+
+func decodeImplMap(d *rowDecoder, v [maxColumns]uint32) ImplMap {
+	return ImplMap{
+		MappingFlags:    PInvokeFlags(v[0]),
+		MemberForwarded: d.coded(&memberForwarded, v[1]),
+		ImportName:      d.string(v[2]),
+		ImportScope:     NewToken(TableModuleRef, v[3]),
+	}
+}
+
+// ImplMapTable returns all of the rows in the ImplMap table.
+func (r *Reader) ImplMapTable() ([]ImplMap, error) {
+	return readRows(r, TableImplMap, decodeImplMap)
+}
+
+// ImplMap returns the row of the ImplMap table with the given 1-based row
+// number.
+func (r *Reader) ImplMap(row uint32) (ImplMap, error) {
+	return readRow(r, TableImplMap, row, decodeImplMap)
+}
+
+// FieldRVA is a row of the FieldRVA table, which records the initial data of
+// a field that is stored within the image.
+type FieldRVA struct {
+	RVA   imagefile.RelativeVirtualAddress
+	Field Token
+}
+
+func decodeFieldRVA(d *rowDecoder, v [maxColumns]uint32) FieldRVA {
+	return FieldRVA{
+		RVA:   imagefile.RelativeVirtualAddress(v[0]),
+		Field: NewToken(TableField, v[1]),
+	}
+}
+
+// FieldRVATable returns all of the rows in the FieldRVA table.
+func (r *Reader) FieldRVATable() ([]FieldRVA, error) {
+	return readRows(r, TableFieldRVA, decodeFieldRVA)
+}
+
+// FieldRVA returns the row of the FieldRVA table with the given 1-based row
+// number.
+func (r *Reader) FieldRVA(row uint32) (FieldRVA, error) {
+	return readRow(r, TableFieldRVA, row, decodeFieldRVA)
+}
+
+// ENCLog is a row of the ENCLog table, which is an edit and continue log
+// entry.
+type ENCLog struct {
+	Token    Token
+	FuncCode uint32
+}
+
+func decodeENCLog(d *rowDecoder, v [maxColumns]uint32) ENCLog {
+	return ENCLog{
+		Token:    Token(v[0]),
+		FuncCode: v[1],
+	}
+}
+
+// ENCLogTable returns all of the rows in the ENCLog table.
+func (r *Reader) ENCLogTable() ([]ENCLog, error) {
+	return readRows(r, TableENCLog, decodeENCLog)
+}
+
+// ENCLog returns the row of the ENCLog table with the given 1-based row
+// number.
+func (r *Reader) ENCLog(row uint32) (ENCLog, error) {
+	return readRow(r, TableENCLog, row, decodeENCLog)
+}
+
+// ENCMap is a row of the ENCMap table, which is an edit and continue mapping
+// entry.
+type ENCMap struct {
+	Token Token
+}
+
+func decodeENCMap(d *rowDecoder, v [maxColumns]uint32) ENCMap {
+	return ENCMap{
+		Token: Token(v[0]),
+	}
+}
+
+// ENCMapTable returns all of the rows in the ENCMap table.
+func (r *Reader) ENCMapTable() ([]ENCMap, error) {
+	return readRows(r, TableENCMap, decodeENCMap)
+}
+
+// ENCMap returns the row of the ENCMap table with the given 1-based row
+// number.
+func (r *Reader) ENCMap(row uint32) (ENCMap, error) {
+	return readRow(r, TableENCMap, row, decodeENCMap)
+}
+
+// Assembly is a row of the Assembly table, which describes the assembly that
+// the module belongs to.
+type Assembly struct {
+	HashAlgorithm HashAlgorithm
+	Version       Version
+	Flags         AssemblyFlags
+	PublicKey     []byte
+	Name          string
+	Culture       string
+}
+
+func decodeAssembly(d *rowDecoder, v [maxColumns]uint32) Assembly {
+	return Assembly{
+		HashAlgorithm: HashAlgorithm(v[0]),
+		Version:       Version{uint16(v[1]), uint16(v[2]), uint16(v[3]), uint16(v[4])},
+		Flags:         AssemblyFlags(v[5]),
+		PublicKey:     d.blob(v[6]),
+		Name:          d.string(v[7]),
+		Culture:       d.string(v[8]),
+	}
+}
+
+// AssemblyTable returns all of the rows in the Assembly table.
+func (r *Reader) AssemblyTable() ([]Assembly, error) {
+	return readRows(r, TableAssembly, decodeAssembly)
+}
+
+// Assembly returns the row of the Assembly table with the given 1-based row
+// number.
+func (r *Reader) Assembly(row uint32) (Assembly, error) {
+	return readRow(r, TableAssembly, row, decodeAssembly)
+}
+
+// AssemblyProcessor is a row of the AssemblyProcessor table, which is an
+// unused table that records a processor supported by the assembly.
+type AssemblyProcessor struct {
+	Processor uint32
+}
+
+func decodeAssemblyProcessor(d *rowDecoder, v [maxColumns]uint32) AssemblyProcessor {
+	return AssemblyProcessor{
+		Processor: v[0],
+	}
+}
+
+// AssemblyProcessorTable returns all of the rows in the AssemblyProcessor
+// table.
+func (r *Reader) AssemblyProcessorTable() ([]AssemblyProcessor, error) {
+	return readRows(r, TableAssemblyProcessor, decodeAssemblyProcessor)
+}
+
+// AssemblyProcessor returns the row of the AssemblyProcessor table with the
+// given 1-based row number.
+func (r *Reader) AssemblyProcessor(row uint32) (AssemblyProcessor, error) {
+	return readRow(r, TableAssemblyProcessor, row, decodeAssemblyProcessor)
+}
+
+// AssemblyOS is a row of the AssemblyOS table, which is an unused table that
+// records an operating system supported by the assembly.
+type AssemblyOS struct {
+	PlatformID   uint32
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+func decodeAssemblyOS(d *rowDecoder, v [maxColumns]uint32) AssemblyOS {
+	return AssemblyOS{
+		PlatformID:   v[0],
+		MajorVersion: v[1],
+		MinorVersion: v[2],
+	}
+}
+
+// AssemblyOSTable returns all of the rows in the AssemblyOS table.
+func (r *Reader) AssemblyOSTable() ([]AssemblyOS, error) {
+	return readRows(r, TableAssemblyOS, decodeAssemblyOS)
+}
+
+// AssemblyOS returns the row of the AssemblyOS table with the given 1-based
+// row number.
+func (r *Reader) AssemblyOS(row uint32) (AssemblyOS, error) {
+	return readRow(r, TableAssemblyOS, row, decodeAssemblyOS)
+}
+
+// AssemblyRef is a row of the AssemblyRef table, which refers to another
+// assembly.
+type AssemblyRef struct {
+	Version          Version
+	Flags            AssemblyFlags
+	PublicKeyOrToken []byte // The full public key if the PublicKey flag is set, otherwise the public key token
+	Name             string
+	Culture          string
+	HashValue        []byte
+}
+
+func decodeAssemblyRef(d *rowDecoder, v [maxColumns]uint32) AssemblyRef {
+	return AssemblyRef{
+		Version:          Version{uint16(v[0]), uint16(v[1]), uint16(v[2]), uint16(v[3])},
+		Flags:            AssemblyFlags(v[4]),
+		PublicKeyOrToken: d.blob(v[5]),
+		Name:             d.string(v[6]),
+		Culture:          d.string(v[7]),
+		HashValue:        d.blob(v[8]),
+	}
+}
+
+// AssemblyRefTable returns all of the rows in the AssemblyRef table.
+func (r *Reader) AssemblyRefTable() ([]AssemblyRef, error) {
+	return readRows(r, TableAssemblyRef, decodeAssemblyRef)
+}
+
+// AssemblyRef returns the row of the AssemblyRef table with the given
+// 1-based row number.
+func (r *Reader) AssemblyRef(row uint32) (AssemblyRef, error) {
+	return readRow(r, TableAssemblyRef, row, decodeAssemblyRef)
+}
+
+// AssemblyRefProcessor is a row of the AssemblyRefProcessor table, which is
+// an unused table that records a processor supported by a referenced
+// assembly.
+type AssemblyRefProcessor struct {
+	Processor   uint32
+	AssemblyRef Token
+}
+
+func decodeAssemblyRefProcessor(d *rowDecoder, v [maxColumns]uint32) AssemblyRefProcessor {
+	return AssemblyRefProcessor{
+		Processor:   v[0],
+		AssemblyRef: NewToken(TableAssemblyRef, v[1]),
+	}
+}
+
+// AssemblyRefProcessorTable returns all of the rows in the
+// AssemblyRefProcessor table.
+func (r *Reader) AssemblyRefProcessorTable() ([]AssemblyRefProcessor, error) {
+	return readRows(r, TableAssemblyRefProcessor, decodeAssemblyRefProcessor)
+}
+
+// AssemblyRefProcessor returns the row of the AssemblyRefProcessor table
+// with the given 1-based row number.
+func (r *Reader) AssemblyRefProcessor(row uint32) (AssemblyRefProcessor, error) {
+	return readRow(r, TableAssemblyRefProcessor, row, decodeAssemblyRefProcessor)
+}
+
+// AssemblyRefOS is a row of the AssemblyRefOS table, which is an unused
+// table that records an operating system supported by a referenced assembly.
+type AssemblyRefOS struct {
+	PlatformID   uint32
+	MajorVersion uint32
+	MinorVersion uint32
+	AssemblyRef  Token
+}
+
+func decodeAssemblyRefOS(d *rowDecoder, v [maxColumns]uint32) AssemblyRefOS {
+	return AssemblyRefOS{
+		PlatformID:   v[0],
+		MajorVersion: v[1],
+		MinorVersion: v[2],
+		AssemblyRef:  NewToken(TableAssemblyRef, v[3]),
+	}
+}
+
+// AssemblyRefOSTable returns all of the rows in the AssemblyRefOS table.
+func (r *Reader) AssemblyRefOSTable() ([]AssemblyRefOS, error) {
+	return readRows(r, TableAssemblyRefOS, decodeAssemblyRefOS)
+}
+
+// AssemblyRefOS returns the row of the AssemblyRefOS table with the given
+// 1-based row number.
+func (r *Reader) AssemblyRefOS(row uint32) (AssemblyRefOS, error) {
+	return readRow(r, TableAssemblyRefOS, row, decodeAssemblyRefOS)
+}
+
+// File is a row of the File table, which refers to another file of a multi-
+// file assembly.
+type File struct {
+	Flags     uint32
+	Name      string
+	HashValue []byte
+}
+
+func decodeFile(d *rowDecoder, v [maxColumns]uint32) File {
+	return File{
+		Flags:     v[0],
+		Name:      d.string(v[1]),
+		HashValue: d.blob(v[2]),
+	}
+}
+
+// FileTable returns all of the rows in the File table.
+func (r *Reader) FileTable() ([]File, error) {
+	return readRows(r, TableFile, decodeFile)
+}
+
+// File returns the row of the File table with the given 1-based row number.
+func (r *Reader) File(row uint32) (File, error) {
+	return readRow(r, TableFile, row, decodeFile)
+}
+
+// ExportedType is a row of the ExportedType table, which records a type that
+// is exported by the assembly but defined in another module, or forwarded to
+// another assembly.
+type ExportedType struct {
+	Flags          uint32
+	TypeDefID      uint32 // A hint for the row of the type in the TypeDef table of the module that defines it
+	Name           string
+	Namespace      string
+	Implementation Token // The File, AssemblyRef or enclosing ExportedType that defines the type
+}
+
+func decodeExportedType(d *rowDecoder, v [maxColumns]uint32) ExportedType {
+	return ExportedType{
+		Flags:          v[0],
+		TypeDefID:      v[1],
+		Name:           d.string(v[2]),
+		Namespace:      d.string(v[3]),
+		Implementation: d.coded(&implementation, v[4]),
+	}
+}
+
+// ExportedTypeTable returns all of the rows in the ExportedType table.
+func (r *Reader) ExportedTypeTable() ([]ExportedType, error) {
+	return readRows(r, TableExportedType, decodeExportedType)
+}
+
+// ExportedType returns the row of the ExportedType table with the given
+// 1-based row number.
+func (r *Reader) ExportedType(row uint32) (ExportedType, error) {
+	return readRow(r, TableExportedType, row, decodeExportedType)
+}
+
+// ManifestResource is a row of the ManifestResource table, which describes a
+// resource of the assembly.
+type ManifestResource struct {
+	Offset         uint32 // The offset of the resource within the CLR resources, if it is embedded
+	Flags          ResourceFlags
+	Name           string
+	Implementation Token // The File or AssemblyRef that holds the resource, or a nil token if it is embedded
+}
+
+func decodeManifestResource(d *rowDecoder, v [maxColumns]uint32) ManifestResource {
+	return ManifestResource{
+		Offset:         v[0],
+		Flags:          ResourceFlags(v[1]),
+		Name:           d.string(v[2]),
+		Implementation: d.coded(&implementation, v[3]),
+	}
+}
+
+// ManifestResourceTable returns all of the rows in the ManifestResource
+// table.
+func (r *Reader) ManifestResourceTable() ([]ManifestResource, error) {
+	return readRows(r, TableManifestResource, decodeManifestResource)
+}
+
+// ManifestResource returns the row of the ManifestResource table with the
+// given 1-based row number.
+func (r *Reader) ManifestResource(row uint32) (ManifestResource, error) {
+	return readRow(r, TableManifestResource, row, decodeManifestResource)
+}
+
+// NestedClass is a row of the NestedClass table, which records a type that
+// is nested within another type.
+type NestedClass struct {
+	NestedClass    Token
+	EnclosingClass Token
+}
+
+func decodeNestedClass(d *rowDecoder, v [maxColumns]uint32) NestedClass {
+	return NestedClass{
+		NestedClass:    NewToken(TableTypeDef, v[0]),
+		EnclosingClass: NewToken(TableTypeDef, v[1]),
+	}
+}
+
+// NestedClassTable returns all of the rows in the NestedClass table.
+func (r *Reader) NestedClassTable() ([]NestedClass, error) {
+	return readRows(r, TableNestedClass, decodeNestedClass)
+}
+
+// NestedClass returns the row of the NestedClass table with the given
+// 1-based row number.
+func (r *Reader) NestedClass(row uint32) (NestedClass, error) {
+	return readRow(r, TableNestedClass, row, decodeNestedClass)
+}
+
+// GenericParam is a row of the GenericParam table, which defines a generic
+// parameter of a type or method.
+type GenericParam struct {
+	Number uint16
+	Flags  uint16
+	Owner  Token
+	Name   string
+}
+
+func decodeGenericParam(d *rowDecoder, v [maxColumns]uint32) GenericParam {
+	return GenericParam{
+		Number: uint16(v[0]),
+		Flags:  uint16(v[1]),
+		Owner:  d.coded(&typeOrMethodDef, v[2]),
+		Name:   d.string(v[3]),
+	}
+}
+
+// GenericParamTable returns all of the rows in the GenericParam table.
+func (r *Reader) GenericParamTable() ([]GenericParam, error) {
+	return readRows(r, TableGenericParam, decodeGenericParam)
+}
+
+// GenericParam returns the row of the GenericParam table with the given
+// 1-based row number.
+func (r *Reader) GenericParam(row uint32) (GenericParam, error) {
+	return readRow(r, TableGenericParam, row, decodeGenericParam)
+}
+
+// MethodSpec is a row of the MethodSpec table, which describes an
+// instantiation of a generic method.
+type MethodSpec struct {
+	Method        Token
+	Instantiation []byte
+}
+
+func decodeMethodSpec(d *rowDecoder, v [maxColumns]uint32) MethodSpec {
+	return MethodSpec{
+		Method:        d.coded(&methodDefOrRef, v[0]),
+		Instantiation: d.blob(v[1]),
+	}
+}
+
+// MethodSpecTable returns all of the rows in the MethodSpec table.
+func (r *Reader) MethodSpecTable() ([]MethodSpec, error) {
+	return readRows(r, TableMethodSpec, decodeMethodSpec)
+}
+
+// MethodSpec returns the row of the MethodSpec table with the given 1-based
+// row number.
+func (r *Reader) MethodSpec(row uint32) (MethodSpec, error) {
+	return readRow(r, TableMethodSpec, row, decodeMethodSpec)
+}
+
+// GenericParamConstraint is a row of the GenericParamConstraint table, which
+// records a constraint on a generic parameter.
+type GenericParamConstraint struct {
+	Owner      Token
+	Constraint Token
+}
+
+func decodeGenericParamConstraint(d *rowDecoder, v [maxColumns]uint32) GenericParamConstraint {
+	return GenericParamConstraint{
+		Owner:      NewToken(TableGenericParam, v[0]),
+		Constraint: d.coded(&typeDefOrRef, v[1]),
+	}
+}
+
+// GenericParamConstraintTable returns all of the rows in the
+// GenericParamConstraint table.
+func (r *Reader) GenericParamConstraintTable() ([]GenericParamConstraint, error) {
+	return readRows(r, TableGenericParamConstraint, decodeGenericParamConstraint)
+}
+
+// GenericParamConstraint returns the row of the GenericParamConstraint table
+// with the given 1-based row number.
+func (r *Reader) GenericParamConstraint(row uint32) (GenericParamConstraint, error) {
+	return readRow(r, TableGenericParamConstraint, row, decodeGenericParamConstraint)
+}
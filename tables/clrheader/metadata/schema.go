@@ -0,0 +1,114 @@
+package metadata
+
+// codedIndex describes a coded index, which refers to a row in one of
+// several tables. The low bits of a coded index hold a tag that selects
+// the table, and the remaining bits hold the row number.
+//
+// ECMA-335 II.24.2.6
+type codedIndex struct {
+	bits   uint
+	tables []TableID
+}
+
+// noTable marks a tag value that is not used by a coded index.
+const noTable TableID = 0xFF
+
+// Coded index kinds.
+var (
+	typeDefOrRef        = codedIndex{2, []TableID{TableTypeDef, TableTypeRef, TableTypeSpec}}
+	hasConstant         = codedIndex{2, []TableID{TableField, TableParam, TableProperty}}
+	hasCustomAttribute  = codedIndex{5, []TableID{TableMethodDef, TableField, TableTypeRef, TableTypeDef, TableParam, TableInterfaceImpl, TableMemberRef, TableModule, TableDeclSecurity, TableProperty, TableEvent, TableStandAloneSig, TableModuleRef, TableTypeSpec, TableAssembly, TableAssemblyRef, TableFile, TableExportedType, TableManifestResource, TableGenericParam, TableGenericParamConstraint, TableMethodSpec}}
+	hasFieldMarshal     = codedIndex{1, []TableID{TableField, TableParam}}
+	hasDeclSecurity     = codedIndex{2, []TableID{TableTypeDef, TableMethodDef, TableAssembly}}
+	memberRefParent     = codedIndex{3, []TableID{TableTypeDef, TableTypeRef, TableModuleRef, TableMethodDef, TableTypeSpec}}
+	hasSemantics        = codedIndex{1, []TableID{TableEvent, TableProperty}}
+	methodDefOrRef      = codedIndex{1, []TableID{TableMethodDef, TableMemberRef}}
+	memberForwarded     = codedIndex{1, []TableID{TableField, TableMethodDef}}
+	implementation      = codedIndex{2, []TableID{TableFile, TableAssemblyRef, TableExportedType}}
+	customAttributeType = codedIndex{3, []TableID{noTable, noTable, TableMethodDef, TableMemberRef, noTable}}
+	resolutionScope     = codedIndex{2, []TableID{TableModule, TableModuleRef, TableAssemblyRef, TableTypeRef}}
+	typeOrMethodDef     = codedIndex{1, []TableID{TableTypeDef, TableMethodDef}}
+)
+
+// columnKind identifies the kind of value held by a column.
+type columnKind uint8
+
+const (
+	columnUint8 columnKind = iota
+	columnUint16
+	columnUint32
+	columnString
+	columnGUID
+	columnBlob
+	columnTable
+	columnCoded
+)
+
+// column describes a column of a metadata table.
+type column struct {
+	kind  columnKind
+	table TableID     // The table referenced by a columnTable column
+	coded *codedIndex // The coded index of a columnCoded column
+}
+
+var (
+	u8     = column{kind: columnUint8}
+	u16    = column{kind: columnUint16}
+	u32    = column{kind: columnUint32}
+	str    = column{kind: columnString}
+	guid   = column{kind: columnGUID}
+	blob   = column{kind: columnBlob}
+	index  = func(table TableID) column { return column{kind: columnTable, table: table} }
+	coding = func(coded *codedIndex) column { return column{kind: columnCoded, coded: coded} }
+)
+
+// schema holds the columns of each metadata table.
+//
+// ECMA-335 II.22
+var schema = [numTables][]column{
+	TableModule:                 {u16, str, guid, guid, guid},
+	TableTypeRef:                {coding(&resolutionScope), str, str},
+	TableTypeDef:                {u32, str, str, coding(&typeDefOrRef), index(TableField), index(TableMethodDef)},
+	TableFieldPtr:               {index(TableField)},
+	TableField:                  {u16, str, blob},
+	TableMethodPtr:              {index(TableMethodDef)},
+	TableMethodDef:              {u32, u16, u16, str, blob, index(TableParam)},
+	TableParamPtr:               {index(TableParam)},
+	TableParam:                  {u16, u16, str},
+	TableInterfaceImpl:          {index(TableTypeDef), coding(&typeDefOrRef)},
+	TableMemberRef:              {coding(&memberRefParent), str, blob},
+	TableConstant:               {u8, u8, coding(&hasConstant), blob},
+	TableCustomAttribute:        {coding(&hasCustomAttribute), coding(&customAttributeType), blob},
+	TableFieldMarshal:           {coding(&hasFieldMarshal), blob},
+	TableDeclSecurity:           {u16, coding(&hasDeclSecurity), blob},
+	TableClassLayout:            {u16, u32, index(TableTypeDef)},
+	TableFieldLayout:            {u32, index(TableField)},
+	TableStandAloneSig:          {blob},
+	TableEventMap:               {index(TableTypeDef), index(TableEvent)},
+	TableEventPtr:               {index(TableEvent)},
+	TableEvent:                  {u16, str, coding(&typeDefOrRef)},
+	TablePropertyMap:            {index(TableTypeDef), index(TableProperty)},
+	TablePropertyPtr:            {index(TableProperty)},
+	TableProperty:               {u16, str, blob},
+	TableMethodSemantics:        {u16, index(TableMethodDef), coding(&hasSemantics)},
+	TableMethodImpl:             {index(TableTypeDef), coding(&methodDefOrRef), coding(&methodDefOrRef)},
+	TableModuleRef:              {str},
+	TableTypeSpec:               {blob},
+	TableImplMap:                {u16, coding(&memberForwarded), str, index(TableModuleRef)},
+	TableFieldRVA:               {u32, index(TableField)},
+	TableENCLog:                 {u32, u32},
+	TableENCMap:                 {u32},
+	TableAssembly:               {u32, u16, u16, u16, u16, u32, blob, str, str},
+	TableAssemblyProcessor:      {u32},
+	TableAssemblyOS:             {u32, u32, u32},
+	TableAssemblyRef:            {u16, u16, u16, u16, u32, blob, str, str, blob},
+	TableAssemblyRefProcessor:   {u32, index(TableAssemblyRef)},
+	TableAssemblyRefOS:          {u32, u32, u32, index(TableAssemblyRef)},
+	TableFile:                   {u32, str, blob},
+	TableExportedType:           {u32, u32, str, str, coding(&implementation)},
+	TableManifestResource:       {u32, u32, str, coding(&implementation)},
+	TableNestedClass:            {index(TableTypeDef), index(TableTypeDef)},
+	TableGenericParam:           {u16, u16, coding(&typeOrMethodDef), str},
+	TableMethodSpec:             {coding(&methodDefOrRef), blob},
+	TableGenericParamConstraint: {index(TableGenericParam), coding(&typeDefOrRef)},
+}
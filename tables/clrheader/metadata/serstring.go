@@ -0,0 +1,26 @@
+package metadata
+
+import "fmt"
+
+// attributeProlog is the value of the first two bytes of a custom
+// attribute value.
+const attributeProlog = 0x0001
+
+// readSerString reads a serialized string from the value of a custom
+// attribute. The string is prefixed by its compressed length, or by the
+// byte 0xFF if it is null. It returns the string and the number of bytes
+// that it occupied.
+func readSerString(data []byte) (string, int, error) {
+	if len(data) > 0 && data[0] == 0xFF {
+		return "", 1, nil
+	}
+	length, n, err := DecodeCompressedUint(data)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read the length of a serialized string: %w", err)
+	}
+	end := n + int(length)
+	if end > len(data) {
+		return "", 0, fmt.Errorf("the serialized string has a length of %d bytes that exceeds the bounds of the value", length)
+	}
+	return string(data[n:end]), end, nil
+}
@@ -0,0 +1,410 @@
+package metadata
+
+import (
+	"fmt"
+)
+
+// CallingConvention holds the calling convention and flags at the start of
+// a signature.
+type CallingConvention uint8
+
+// Calling conventions and signature kinds.
+//
+// ECMA-335 II.23.2.1
+const (
+	CallingDefault      CallingConvention = 0x00 // A managed method
+	CallingC            CallingConvention = 0x01 // An unmanaged method with the cdecl calling convention
+	CallingStdcall      CallingConvention = 0x02 // An unmanaged method with the stdcall calling convention
+	CallingThiscall     CallingConvention = 0x03 // An unmanaged method with the thiscall calling convention
+	CallingFastcall     CallingConvention = 0x04 // An unmanaged method with the fastcall calling convention
+	CallingVarArg       CallingConvention = 0x05 // A managed method with a variable number of arguments
+	CallingField        CallingConvention = 0x06 // A field signature
+	CallingLocalSig     CallingConvention = 0x07 // A local variable signature
+	CallingProperty     CallingConvention = 0x08 // A property signature
+	CallingUnmanaged    CallingConvention = 0x09 // An unmanaged method with a calling convention given by modifiers
+	CallingGenericInst  CallingConvention = 0x0A // A generic method instantiation
+	CallingGeneric      CallingConvention = 0x10 // The method has generic parameters
+	CallingHasThis      CallingConvention = 0x20 // The method has an implicit this parameter
+	CallingExplicitThis CallingConvention = 0x40 // The this parameter is explicitly included in the parameter list
+	callingKindMask     CallingConvention = 0x0F
+)
+
+// Kind returns the kind of signature, without its flags.
+func (conv CallingConvention) Kind() CallingConvention {
+	return conv & callingKindMask
+}
+
+// HasThis returns true if the method has an implicit this parameter.
+func (conv CallingConvention) HasThis() bool {
+	return conv&CallingHasThis != 0
+}
+
+// Generic returns true if the method has generic parameters.
+func (conv CallingConvention) Generic() bool {
+	return conv&CallingGeneric != 0
+}
+
+// Modifier is a custom modifier that is applied to a type within a
+// signature.
+type Modifier struct {
+	Required bool  // True for modreq, false for modopt
+	Type     Token // The TypeDef, TypeRef or TypeSpec of the modifier
+}
+
+// ArrayShape describes the dimensions of a general array.
+type ArrayShape struct {
+	Rank        uint32
+	Sizes       []uint32
+	LowerBounds []int32
+}
+
+// SignatureType is a type within a signature.
+//
+// ECMA-335 II.23.2.12
+type SignatureType struct {
+	Element   ElementType
+	Modifiers []Modifier
+
+	// Token refers to the TypeDef, TypeRef or TypeSpec of a class or value
+	// type. It is also used for the generic type of a generic
+	// instantiation.
+	Token Token
+
+	// Elem is the element type of a pointer, reference, array or pinned
+	// type. For a generic instantiation it is the generic type.
+	Elem *SignatureType
+
+	// Args holds the type arguments of a generic instantiation.
+	Args []SignatureType
+
+	// Number is the index of a generic parameter.
+	Number uint32
+
+	// Shape describes the dimensions of a general array.
+	Shape *ArrayShape
+
+	// Method is the signature of a function pointer.
+	Method *MethodSignature
+}
+
+// MethodSignature is the signature of a method or property.
+//
+// ECMA-335 II.23.2.1
+type MethodSignature struct {
+	CallingConvention CallingConvention
+	GenericParams     uint32
+	Return            SignatureType
+	Params            []SignatureType
+
+	// VarArgs holds the types of the optional parameters that follow the
+	// sentinel of a call to a method with a variable number of arguments.
+	VarArgs []SignatureType
+}
+
+// ParseMethodSignature parses a method, method reference or property
+// signature.
+func ParseMethodSignature(data []byte) (MethodSignature, error) {
+	s := sigReader{data: data}
+	sig, err := s.methodSignature()
+	if err != nil {
+		return MethodSignature{}, fmt.Errorf("failed to parse method signature: %w", err)
+	}
+	return sig, nil
+}
+
+// ParseFieldSignature parses a field signature and returns the type of
+// the field.
+func ParseFieldSignature(data []byte) (SignatureType, error) {
+	s := sigReader{data: data}
+	conv, err := s.byte()
+	if err != nil {
+		return SignatureType{}, err
+	}
+	if CallingConvention(conv).Kind() != CallingField {
+		return SignatureType{}, fmt.Errorf("the signature is not a field signature: 0x%02x", conv)
+	}
+	t, err := s.typ()
+	if err != nil {
+		return SignatureType{}, fmt.Errorf("failed to parse field signature: %w", err)
+	}
+	return t, nil
+}
+
+// ParseLocalSignature parses a local variable signature and returns the
+// types of the local variables.
+func ParseLocalSignature(data []byte) ([]SignatureType, error) {
+	s := sigReader{data: data}
+	conv, err := s.byte()
+	if err != nil {
+		return nil, err
+	}
+	if CallingConvention(conv).Kind() != CallingLocalSig {
+		return nil, fmt.Errorf("the signature is not a local variable signature: 0x%02x", conv)
+	}
+	locals, err := s.types()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse local variable signature: %w", err)
+	}
+	return locals, nil
+}
+
+// ParseMethodSpecSignature parses the instantiation of a generic method
+// and returns its type arguments.
+func ParseMethodSpecSignature(data []byte) ([]SignatureType, error) {
+	s := sigReader{data: data}
+	conv, err := s.byte()
+	if err != nil {
+		return nil, err
+	}
+	if CallingConvention(conv).Kind() != CallingGenericInst {
+		return nil, fmt.Errorf("the signature is not a generic method instantiation: 0x%02x", conv)
+	}
+	args, err := s.types()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse generic method instantiation: %w", err)
+	}
+	return args, nil
+}
+
+// ParseTypeSignature parses a type signature, such as the signature of a
+// TypeSpec.
+func ParseTypeSignature(data []byte) (SignatureType, error) {
+	s := sigReader{data: data}
+	t, err := s.typ()
+	if err != nil {
+		return SignatureType{}, fmt.Errorf("failed to parse type signature: %w", err)
+	}
+	return t, nil
+}
+
+// maxSignatureDepth limits the nesting of types within a signature.
+const maxSignatureDepth = 64
+
+// sigReader reads the components of a signature.
+type sigReader struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (s *sigReader) byte() (byte, error) {
+	if s.pos >= len(s.data) {
+		return 0, fmt.Errorf("the signature is truncated")
+	}
+	b := s.data[s.pos]
+	s.pos++
+	return b, nil
+}
+
+func (s *sigReader) peek() (byte, error) {
+	if s.pos >= len(s.data) {
+		return 0, fmt.Errorf("the signature is truncated")
+	}
+	return s.data[s.pos], nil
+}
+
+func (s *sigReader) uint() (uint32, error) {
+	value, n, err := DecodeCompressedUint(s.data[s.pos:])
+	if err != nil {
+		return 0, err
+	}
+	s.pos += n
+	return value, nil
+}
+
+func (s *sigReader) int() (int32, error) {
+	value, n, err := DecodeCompressedInt(s.data[s.pos:])
+	if err != nil {
+		return 0, err
+	}
+	s.pos += n
+	return value, nil
+}
+
+// token reads a TypeDefOrRefOrSpecEncoded value.
+func (s *sigReader) token() (Token, error) {
+	value, err := s.uint()
+	if err != nil {
+		return 0, err
+	}
+	return decodeCodedIndex(&typeDefOrRef, value)
+}
+
+func (s *sigReader) methodSignature() (MethodSignature, error) {
+	conv, err := s.byte()
+	if err != nil {
+		return MethodSignature{}, err
+	}
+	sig := MethodSignature{CallingConvention: CallingConvention(conv)}
+	if sig.CallingConvention.Generic() {
+		if sig.GenericParams, err = s.uint(); err != nil {
+			return MethodSignature{}, err
+		}
+	}
+	count, err := s.uint()
+	if err != nil {
+		return MethodSignature{}, err
+	}
+	if int(count) > len(s.data)-s.pos {
+		return MethodSignature{}, fmt.Errorf("the signature has %d parameters that exceed its length", count)
+	}
+	if sig.Return, err = s.typ(); err != nil {
+		return MethodSignature{}, err
+	}
+	sentinel := false
+	for range count {
+		if b, err := s.peek(); err == nil && ElementType(b) == ElementSentinel {
+			s.pos++
+			sentinel = true
+		}
+		param, err := s.typ()
+		if err != nil {
+			return MethodSignature{}, err
+		}
+		if sentinel {
+			sig.VarArgs = append(sig.VarArgs, param)
+		} else {
+			sig.Params = append(sig.Params, param)
+		}
+	}
+	return sig, nil
+}
+
+// types reads a count followed by that number of types.
+func (s *sigReader) types() ([]SignatureType, error) {
+	count, err := s.uint()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > len(s.data)-s.pos {
+		return nil, fmt.Errorf("the signature has %d types that exceed its length", count)
+	}
+	types := make([]SignatureType, 0, count)
+	for range count {
+		t, err := s.typ()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+func (s *sigReader) typ() (SignatureType, error) {
+	s.depth++
+	defer func() { s.depth-- }()
+	if s.depth > maxSignatureDepth {
+		return SignatureType{}, fmt.Errorf("the signature exceeds the maximum nesting depth of %d", maxSignatureDepth)
+	}
+
+	var t SignatureType
+
+	// Custom modifiers precede the type that they apply to.
+	for {
+		b, err := s.peek()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		element := ElementType(b)
+		if element != ElementCModReqd && element != ElementCModOpt {
+			break
+		}
+		s.pos++
+		token, err := s.token()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		t.Modifiers = append(t.Modifiers, Modifier{Required: element == ElementCModReqd, Type: token})
+	}
+
+	b, err := s.byte()
+	if err != nil {
+		return SignatureType{}, err
+	}
+	t.Element = ElementType(b)
+
+	switch t.Element {
+	case ElementVoid, ElementBoolean, ElementChar, ElementInt8, ElementUint8,
+		ElementInt16, ElementUint16, ElementInt32, ElementUint32, ElementInt64,
+		ElementUint64, ElementFloat32, ElementFloat64, ElementString,
+		ElementTypedByRef, ElementIntPtr, ElementUintPtr, ElementObject:
+	case ElementClass, ElementValueType:
+		if t.Token, err = s.token(); err != nil {
+			return SignatureType{}, err
+		}
+	case ElementPtr, ElementByRef, ElementSZArray, ElementPinned:
+		elem, err := s.typ()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		t.Elem = &elem
+	case ElementVar, ElementMVar:
+		if t.Number, err = s.uint(); err != nil {
+			return SignatureType{}, err
+		}
+	case ElementGenericInst:
+		elem, err := s.typ()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		t.Elem = &elem
+		if t.Args, err = s.types(); err != nil {
+			return SignatureType{}, err
+		}
+	case ElementArray:
+		elem, err := s.typ()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		t.Elem = &elem
+		if t.Shape, err = s.arrayShape(); err != nil {
+			return SignatureType{}, err
+		}
+	case ElementFnPtr:
+		method, err := s.methodSignature()
+		if err != nil {
+			return SignatureType{}, err
+		}
+		t.Method = &method
+	default:
+		return SignatureType{}, fmt.Errorf("the signature has an unexpected element type of 0x%02x", b)
+	}
+
+	return t, nil
+}
+
+func (s *sigReader) arrayShape() (*ArrayShape, error) {
+	var shape ArrayShape
+	var err error
+	if shape.Rank, err = s.uint(); err != nil {
+		return nil, err
+	}
+	count, err := s.uint()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > len(s.data)-s.pos {
+		return nil, fmt.Errorf("the array shape has %d sizes that exceed the length of the signature", count)
+	}
+	for range count {
+		size, err := s.uint()
+		if err != nil {
+			return nil, err
+		}
+		shape.Sizes = append(shape.Sizes, size)
+	}
+	if count, err = s.uint(); err != nil {
+		return nil, err
+	}
+	if int(count) > len(s.data)-s.pos {
+		return nil, fmt.Errorf("the array shape has %d lower bounds that exceed the length of the signature", count)
+	}
+	for range count {
+		bound, err := s.int()
+		if err != nil {
+			return nil, err
+		}
+		shape.LowerBounds = append(shape.LowerBounds, bound)
+	}
+	return &shape, nil
+}
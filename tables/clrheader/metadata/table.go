@@ -0,0 +1,188 @@
+package metadata
+
+import "fmt"
+
+// TableID identifies a metadata table.
+type TableID uint8
+
+// Metadata tables.
+//
+// ECMA-335 II.22
+const (
+	TableModule                 TableID = 0x00
+	TableTypeRef                TableID = 0x01
+	TableTypeDef                TableID = 0x02
+	TableFieldPtr               TableID = 0x03
+	TableField                  TableID = 0x04
+	TableMethodPtr              TableID = 0x05
+	TableMethodDef              TableID = 0x06
+	TableParamPtr               TableID = 0x07
+	TableParam                  TableID = 0x08
+	TableInterfaceImpl          TableID = 0x09
+	TableMemberRef              TableID = 0x0A
+	TableConstant               TableID = 0x0B
+	TableCustomAttribute        TableID = 0x0C
+	TableFieldMarshal           TableID = 0x0D
+	TableDeclSecurity           TableID = 0x0E
+	TableClassLayout            TableID = 0x0F
+	TableFieldLayout            TableID = 0x10
+	TableStandAloneSig          TableID = 0x11
+	TableEventMap               TableID = 0x12
+	TableEventPtr               TableID = 0x13
+	TableEvent                  TableID = 0x14
+	TablePropertyMap            TableID = 0x15
+	TablePropertyPtr            TableID = 0x16
+	TableProperty               TableID = 0x17
+	TableMethodSemantics        TableID = 0x18
+	TableMethodImpl             TableID = 0x19
+	TableModuleRef              TableID = 0x1A
+	TableTypeSpec               TableID = 0x1B
+	TableImplMap                TableID = 0x1C
+	TableFieldRVA               TableID = 0x1D
+	TableENCLog                 TableID = 0x1E
+	TableENCMap                 TableID = 0x1F
+	TableAssembly               TableID = 0x20
+	TableAssemblyProcessor      TableID = 0x21
+	TableAssemblyOS             TableID = 0x22
+	TableAssemblyRef            TableID = 0x23
+	TableAssemblyRefProcessor   TableID = 0x24
+	TableAssemblyRefOS          TableID = 0x25
+	TableFile                   TableID = 0x26
+	TableExportedType           TableID = 0x27
+	TableManifestResource       TableID = 0x28
+	TableNestedClass            TableID = 0x29
+	TableGenericParam           TableID = 0x2A
+	TableMethodSpec             TableID = 0x2B
+	TableGenericParamConstraint TableID = 0x2C
+)
+
+// numTables is the number of metadata tables that are understood.
+const numTables = int(TableGenericParamConstraint) + 1
+
+// String returns a string representation of the table ID.
+func (id TableID) String() string {
+	switch id {
+	case TableModule:
+		return "Module"
+	case TableTypeRef:
+		return "TypeRef"
+	case TableTypeDef:
+		return "TypeDef"
+	case TableFieldPtr:
+		return "FieldPtr"
+	case TableField:
+		return "Field"
+	case TableMethodPtr:
+		return "MethodPtr"
+	case TableMethodDef:
+		return "MethodDef"
+	case TableParamPtr:
+		return "ParamPtr"
+	case TableParam:
+		return "Param"
+	case TableInterfaceImpl:
+		return "InterfaceImpl"
+	case TableMemberRef:
+		return "MemberRef"
+	case TableConstant:
+		return "Constant"
+	case TableCustomAttribute:
+		return "CustomAttribute"
+	case TableFieldMarshal:
+		return "FieldMarshal"
+	case TableDeclSecurity:
+		return "DeclSecurity"
+	case TableClassLayout:
+		return "ClassLayout"
+	case TableFieldLayout:
+		return "FieldLayout"
+	case TableStandAloneSig:
+		return "StandAloneSig"
+	case TableEventMap:
+		return "EventMap"
+	case TableEventPtr:
+		return "EventPtr"
+	case TableEvent:
+		return "Event"
+	case TablePropertyMap:
+		return "PropertyMap"
+	case TablePropertyPtr:
+		return "PropertyPtr"
+	case TableProperty:
+		return "Property"
+	case TableMethodSemantics:
+		return "MethodSemantics"
+	case TableMethodImpl:
+		return "MethodImpl"
+	case TableModuleRef:
+		return "ModuleRef"
+	case TableTypeSpec:
+		return "TypeSpec"
+	case TableImplMap:
+		return "ImplMap"
+	case TableFieldRVA:
+		return "FieldRVA"
+	case TableENCLog:
+		return "ENCLog"
+	case TableENCMap:
+		return "ENCMap"
+	case TableAssembly:
+		return "Assembly"
+	case TableAssemblyProcessor:
+		return "AssemblyProcessor"
+	case TableAssemblyOS:
+		return "AssemblyOS"
+	case TableAssemblyRef:
+		return "AssemblyRef"
+	case TableAssemblyRefProcessor:
+		return "AssemblyRefProcessor"
+	case TableAssemblyRefOS:
+		return "AssemblyRefOS"
+	case TableFile:
+		return "File"
+	case TableExportedType:
+		return "ExportedType"
+	case TableManifestResource:
+		return "ManifestResource"
+	case TableNestedClass:
+		return "NestedClass"
+	case TableGenericParam:
+		return "GenericParam"
+	case TableMethodSpec:
+		return "MethodSpec"
+	case TableGenericParamConstraint:
+		return "GenericParamConstraint"
+	default:
+		return fmt.Sprintf("<unrecognized table: 0x%02x>", uint8(id))
+	}
+}
+
+// Token is a metadata token, which identifies a row within a metadata
+// table. The most significant byte holds the table ID and the remaining
+// bytes hold the 1-based row number.
+type Token uint32
+
+// NewToken returns a token for the given row within the given table.
+func NewToken(table TableID, row uint32) Token {
+	return Token(uint32(table)<<24 | row&0x00FFFFFF)
+}
+
+// Table returns the table that the token refers to.
+func (token Token) Table() TableID {
+	return TableID(token >> 24)
+}
+
+// Row returns the 1-based row number that the token refers to.
+func (token Token) Row() uint32 {
+	return uint32(token) & 0x00FFFFFF
+}
+
+// IsNil returns true if the token does not refer to a row.
+func (token Token) IsNil() bool {
+	return token.Row() == 0
+}
+
+// String returns a string representation of the token.
+func (token Token) String() string {
+	return fmt.Sprintf("0x%08x", uint32(token))
+}
@@ -0,0 +1,188 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// Heap size flags within the header of the tables stream.
+const (
+	heapStringsWide = 0x01 // Indices into the #Strings heap are 4 bytes wide
+	heapGUIDWide    = 0x02 // Indices into the #GUID heap are 4 bytes wide
+	heapBlobWide    = 0x04 // Indices into the #Blob heap are 4 bytes wide
+	heapExtraData   = 0x40 // An extra 4 bytes of data follow the row counts
+)
+
+// maxColumns is the largest number of columns held by any table.
+const maxColumns = 9
+
+// TableInfo describes a metadata table within the tables stream.
+type TableInfo struct {
+	ID      TableID
+	Rows    uint32
+	RowSize uint
+	Sorted  bool
+}
+
+// tableStream holds the parsed layout of the tables stream.
+type tableStream struct {
+	data         []byte
+	majorVersion uint8
+	minorVersion uint8
+	heapSizes    uint8
+	valid        uint64
+	sorted       uint64
+	rows         [64]uint32
+	offsets      [numTables]uint
+	rowSizes     [numTables]uint
+	widths       [numTables][maxColumns]uint8
+}
+
+func parseTableStream(data []byte) (*tableStream, error) {
+	if len(data) < 24 {
+		return nil, fmt.Errorf("the tables stream is %d byte(s) long when at least 24 bytes are required", len(data))
+	}
+	ts := &tableStream{
+		data:         data,
+		majorVersion: data[4],
+		minorVersion: data[5],
+		heapSizes:    data[6],
+		valid:        binary.LittleEndian.Uint64(data[8:16]),
+		sorted:       binary.LittleEndian.Uint64(data[16:24]),
+	}
+
+	// Each table that is present has a row count.
+	offset := uint(24)
+	for id := range 64 {
+		if ts.valid&(1<<id) == 0 {
+			continue
+		}
+		if offset+4 > uint(len(data)) {
+			return nil, fmt.Errorf("the tables stream is truncated within its row counts")
+		}
+		ts.rows[id] = binary.LittleEndian.Uint32(data[offset : offset+4])
+		offset += 4
+	}
+	if ts.heapSizes&heapExtraData != 0 {
+		offset += 4
+	}
+
+	// Determine the width of each column, which depends on the sizes of
+	// the heaps and the number of rows in the referenced tables.
+	for id := range numTables {
+		var size uint
+		for i, col := range schema[id] {
+			width := ts.columnWidth(col)
+			ts.widths[id][i] = uint8(width)
+			size += width
+		}
+		ts.rowSizes[id] = size
+	}
+
+	// The tables are stored one after another in order of their IDs.
+	// Tables that aren't understood can only appear after the others,
+	// so their presence doesn't affect the layout of the known tables.
+	for id := range numTables {
+		ts.offsets[id] = offset
+		length := uint64(ts.rows[id]) * uint64(ts.rowSizes[id])
+		if uint64(offset)+length > uint64(len(data)) {
+			return nil, fmt.Errorf("the %s table has %d row(s) that exceed the bounds of the tables stream", TableID(id), ts.rows[id])
+		}
+		offset += uint(length)
+	}
+
+	return ts, nil
+}
+
+func (ts *tableStream) columnWidth(col column) uint {
+	switch col.kind {
+	case columnUint8:
+		return 1
+	case columnUint16:
+		return 2
+	case columnUint32:
+		return 4
+	case columnString:
+		return ts.heapWidth(heapStringsWide)
+	case columnGUID:
+		return ts.heapWidth(heapGUIDWide)
+	case columnBlob:
+		return ts.heapWidth(heapBlobWide)
+	case columnTable:
+		if ts.rows[col.table] > 0xFFFF {
+			return 4
+		}
+		return 2
+	case columnCoded:
+		var largest uint32
+		for _, table := range col.coded.tables {
+			if table != noTable {
+				largest = max(largest, ts.rows[table])
+			}
+		}
+		if largest >= 1<<(16-col.coded.bits) {
+			return 4
+		}
+		return 2
+	default:
+		return 0
+	}
+}
+
+func (ts *tableStream) heapWidth(flag uint8) uint {
+	if ts.heapSizes&flag != 0 {
+		return 4
+	}
+	return 2
+}
+
+// tables returns information about each of the known tables that are
+// present within the stream.
+func (ts *tableStream) tables() []TableInfo {
+	infos := make([]TableInfo, 0, bits.OnesCount64(ts.valid))
+	for id := range numTables {
+		if ts.valid&(1<<id) == 0 {
+			continue
+		}
+		infos = append(infos, TableInfo{
+			ID:      TableID(id),
+			Rows:    ts.rows[id],
+			RowSize: ts.rowSizes[id],
+			Sorted:  ts.sorted&(1<<id) != 0,
+		})
+	}
+	return infos
+}
+
+// row returns the column values of the given 1-based row within table.
+func (ts *tableStream) row(table TableID, row uint32) (values [maxColumns]uint32, err error) {
+	if int(table) >= numTables {
+		return values, fmt.Errorf("the metadata table %s is not supported", table)
+	}
+	if row == 0 || row > ts.rows[table] {
+		return values, fmt.Errorf("row %d is not present in the %s table, which has %d row(s)", row, table, ts.rows[table])
+	}
+	offset := ts.offsets[table] + uint(row-1)*ts.rowSizes[table]
+	for i := range schema[table] {
+		switch ts.widths[table][i] {
+		case 1:
+			values[i] = uint32(ts.data[offset])
+		case 2:
+			values[i] = uint32(binary.LittleEndian.Uint16(ts.data[offset:]))
+		case 4:
+			values[i] = binary.LittleEndian.Uint32(ts.data[offset:])
+		}
+		offset += uint(ts.widths[table][i])
+	}
+	return values, nil
+}
+
+// decodeCodedIndex converts the value of a coded index column to a token.
+func decodeCodedIndex(coded *codedIndex, value uint32) (Token, error) {
+	tag := value & (1<<coded.bits - 1)
+	if int(tag) >= len(coded.tables) || coded.tables[tag] == noTable {
+		return 0, fmt.Errorf("the coded index 0x%x has an invalid tag of %d", value, tag)
+	}
+	return NewToken(coded.tables[tag], value>>coded.bits), nil
+}
@@ -0,0 +1,119 @@
+package metadata
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TypeName holds the namespace and name of a type.
+type TypeName struct {
+	Namespace string
+	Name      string
+}
+
+// String returns the full name of the type.
+func (name TypeName) String() string {
+	if name.Namespace == "" {
+		return name.Name
+	}
+	return name.Namespace + "." + name.Name
+}
+
+// TypeName returns the name of the type referred to by the given TypeDef
+// or TypeRef token.
+func (r *Reader) TypeName(token Token) (TypeName, error) {
+	switch token.Table() {
+	case TableTypeDef:
+		def, err := r.TypeDef(token.Row())
+		if err != nil {
+			return TypeName{}, err
+		}
+		return TypeName{Namespace: def.Namespace, Name: def.Name}, nil
+	case TableTypeRef:
+		ref, err := r.TypeRef(token.Row())
+		if err != nil {
+			return TypeName{}, err
+		}
+		return TypeName{Namespace: ref.Namespace, Name: ref.Name}, nil
+	default:
+		return TypeName{}, fmt.Errorf("the token %s does not refer to a TypeDef or TypeRef", token)
+	}
+}
+
+// MethodOwner returns the TypeDef token of the type that defines the
+// method with the given MethodDef row.
+func (r *Reader) MethodOwner(method uint32) (Token, error) {
+	return r.listOwner(TableMethodDef, 5, method)
+}
+
+// FieldOwner returns the TypeDef token of the type that defines the field
+// with the given Field row.
+func (r *Reader) FieldOwner(field uint32) (Token, error) {
+	return r.listOwner(TableField, 4, field)
+}
+
+// listOwner returns the TypeDef token of the type that owns the given row
+// of a table, by way of the TypeDef column that holds the first row of
+// each type's list.
+func (r *Reader) listOwner(table TableID, column int, row uint32) (Token, error) {
+	count := r.RowCount(TableTypeDef)
+	if row == 0 || row > r.RowCount(table) {
+		return 0, fmt.Errorf("row %d is not present in the %s table", row, table)
+	}
+
+	// The lists of the types are stored in ascending order, so the owner
+	// is the last type with a list that starts at or before the row.
+	var err error
+	index := sort.Search(int(count), func(i int) bool {
+		values, e := r.tables.row(TableTypeDef, uint32(i+1))
+		if e != nil {
+			err = e
+			return true
+		}
+		return values[column] > row
+	})
+	if err != nil {
+		return 0, err
+	}
+	if index == 0 {
+		return 0, fmt.Errorf("row %d of the %s table is not owned by any type", row, table)
+	}
+	return NewToken(TableTypeDef, uint32(index)), nil
+}
+
+// AttributeType returns the name of the type of the given custom
+// attribute, which is the type that defines its constructor.
+func (r *Reader) AttributeType(attribute CustomAttribute) (TypeName, error) {
+	switch attribute.Type.Table() {
+	case TableMethodDef:
+		owner, err := r.MethodOwner(attribute.Type.Row())
+		if err != nil {
+			return TypeName{}, err
+		}
+		return r.TypeName(owner)
+	case TableMemberRef:
+		ref, err := r.MemberRef(attribute.Type.Row())
+		if err != nil {
+			return TypeName{}, err
+		}
+		if ref.Class.Table() == TableTypeSpec {
+			// The constructor belongs to an instantiation of a generic
+			// attribute type.
+			spec, err := r.TypeSpec(ref.Class.Row())
+			if err != nil {
+				return TypeName{}, err
+			}
+			t, err := ParseTypeSignature(spec.Signature)
+			if err != nil {
+				return TypeName{}, err
+			}
+			if t.Element != ElementGenericInst {
+				return TypeName{}, fmt.Errorf("the custom attribute constructor belongs to a %s type", t.Element)
+			}
+			return r.TypeName(t.Elem.Token)
+		}
+		return r.TypeName(ref.Class)
+	default:
+		return TypeName{}, fmt.Errorf("the custom attribute has a constructor token %s that does not refer to a MethodDef or MemberRef", attribute.Type)
+	}
+}
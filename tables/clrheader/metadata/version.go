@@ -0,0 +1,16 @@
+package metadata
+
+import "fmt"
+
+// Version is a four part assembly version number.
+type Version struct {
+	Major    uint16
+	Minor    uint16
+	Build    uint16
+	Revision uint16
+}
+
+// String returns a string representation of the version.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Build, v.Revision)
+}
@@ -0,0 +1,233 @@
+package clrheader
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+var (
+	// ErrMissingCLRHeader is returned by [NewReader] if it is asked to
+	// operate on a portable executable that doesn't have a CLR header.
+	ErrMissingCLRHeader = errors.New("the portable executable does not have a CLR header")
+
+	// ErrMissingMetadata is returned when the CLR header doesn't reference
+	// any metadata.
+	ErrMissingMetadata = errors.New("the CLR header does not reference any metadata")
+
+	// ErrMissingResources is returned when the CLR header doesn't
+	// reference any managed resources.
+	ErrMissingResources = errors.New("the CLR header does not reference any managed resources")
+)
+
+// metadataSignature is the signature at the start of the metadata root,
+// which spells "BSJB".
+const metadataSignature = 0x424A5342
+
+// Reader reads the CLR runtime header of a portable executable image file
+// from an underlying [portableexecutable.Reader].
+type Reader struct {
+	pe       *portableexecutable.Reader
+	location imagefile.FileRange
+}
+
+// NewReader creates and initializes a new CLR header [Reader] that reads
+// from portable executable [portableexecutable.Reader] pe. It returns
+// [ErrMissingCLRHeader] if the portable executable does not have a CLR
+// header.
+func NewReader(pe *portableexecutable.Reader) (*Reader, error) {
+	dir := pe.DataDirectories().Get(imagefile.CLRRuntimeHeaderID)
+	if dir.IsZero() {
+		return nil, ErrMissingCLRHeader
+	}
+
+	return &Reader{
+		pe:       pe,
+		location: dir.Location,
+	}, nil
+}
+
+// PE returns the portable executable reader that r reads from.
+func (r *Reader) PE() *portableexecutable.Reader {
+	return r.pe
+}
+
+// ReadHeader reads the CLR header and returns it. The relative virtual
+// addresses of the directories it references are translated to file
+// offsets.
+func (r *Reader) ReadHeader() (Header, error) {
+	if r.location.Length < headerSize {
+		return Header{}, fmt.Errorf("the CLR header is %d byte(s) long when at least %d bytes are required", r.location.Length, headerSize)
+	}
+	data, err := r.pe.ReadRange(imagefile.FileRange{Start: r.location.Start, Length: headerSize})
+	if err != nil {
+		return Header{}, fmt.Errorf("failed to read the CLR header: %w", err)
+	}
+	raw := headerData(data)
+
+	header := Header{
+		Size:                raw.Size(),
+		MajorRuntimeVersion: raw.MajorRuntimeVersion(),
+		MinorRuntimeVersion: raw.MinorRuntimeVersion(),
+		Flags:               raw.Flags(),
+		EntryPoint:          raw.EntryPoint(),
+	}
+
+	dirs := []struct {
+		name  string
+		entry imagefile.DataDirectory
+		dir   *Directory
+	}{
+		{"metadata", raw.Metadata(), &header.Metadata},
+		{"resources", raw.Resources(), &header.Resources},
+		{"strong name signature", raw.StrongNameSignature(), &header.StrongNameSignature},
+		{"code manager table", raw.CodeManagerTable(), &header.CodeManagerTable},
+		{"VTable fixups", raw.VTableFixups(), &header.VTableFixups},
+		{"export address table jumps", raw.ExportAddressTableJumps(), &header.ExportAddressTableJumps},
+		{"managed native header", raw.ManagedNativeHeader(), &header.ManagedNativeHeader},
+	}
+	for _, dir := range dirs {
+		if dir.entry.IsZero() {
+			continue
+		}
+		addressRange := imagefile.RelativeVirtualAddressRange{
+			Start:  imagefile.RelativeVirtualAddress(dir.entry.Address()),
+			Length: uint(dir.entry.Size()),
+		}
+		ok, location := r.pe.Sections().TranslateRange(addressRange)
+		if !ok {
+			return Header{}, fmt.Errorf("the CLR header has a %s virtual address range (%s) that is not mapped to any section within the image file", dir.name, addressRange)
+		}
+		*dir.dir = Directory{
+			Address:  addressRange,
+			Location: location,
+		}
+	}
+
+	return header, nil
+}
+
+// ReadData reads the data referenced by the given directory.
+func (r *Reader) ReadData(dir Directory) ([]byte, error) {
+	return r.pe.ReadRange(dir.Location)
+}
+
+// ReadResource reads the embedded managed resource at the given offset
+// within the managed resources referenced by the CLR header. The offset
+// is recorded by the ManifestResource metadata table. It returns
+// [ErrMissingResources] if the CLR header doesn't reference any managed
+// resources.
+func (r *Reader) ReadResource(header Header, offset uint32) ([]byte, error) {
+	if header.Resources.IsZero() {
+		return nil, ErrMissingResources
+	}
+
+	// Each resource is prefixed by its length.
+	resources := header.Resources.Location
+	if uint(offset) > resources.Length || resources.Length-uint(offset) < 4 {
+		return nil, fmt.Errorf("the managed resource at offset 0x%x exceeds the bounds of the managed resources", offset)
+	}
+	prefix, err := r.pe.ReadRange(imagefile.FileRange{
+		Start:  resources.Start + imagefile.FileOffset(offset),
+		Length: 4,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the length of the managed resource at offset 0x%x: %w", offset, err)
+	}
+	length := uint(binary.LittleEndian.Uint32(prefix))
+	if length > resources.Length-uint(offset)-4 {
+		return nil, fmt.Errorf("the managed resource at offset 0x%x has a length of %d bytes that exceeds the bounds of the managed resources", offset, length)
+	}
+	data, err := r.pe.ReadRange(imagefile.FileRange{
+		Start:  resources.Start + imagefile.FileOffset(offset) + 4,
+		Length: length,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the managed resource at offset 0x%x: %w", offset, err)
+	}
+	return data, nil
+}
+
+// ReadMetadataVersion reads the version string from the metadata root,
+// which identifies the version of the runtime that the image was built
+// for, such as "v4.0.30319".
+func (r *Reader) ReadMetadataVersion(header Header) (string, error) {
+	if header.Metadata.IsZero() {
+		return "", ErrMissingMetadata
+	}
+	data, err := r.pe.ReadRange(imagefile.FileRange{
+		Start:  header.Metadata.Location.Start,
+		Length: min(header.Metadata.Location.Length, 16+255),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read the metadata root: %w", err)
+	}
+	if len(data) < 16 {
+		return "", fmt.Errorf("the metadata root is %d byte(s) long when at least 16 bytes are required", len(data))
+	}
+	if signature := binary.LittleEndian.Uint32(data[0:4]); signature != metadataSignature {
+		return "", fmt.Errorf("the metadata root does not have the expected signature")
+	}
+	length := uint(binary.LittleEndian.Uint32(data[12:16]))
+	if length > uint(len(data)-16) {
+		return "", fmt.Errorf("the metadata root has a version string of %d bytes that exceeds the size of the metadata", length)
+	}
+	version := data[16 : 16+length]
+	if cutoff := bytes.IndexByte(version, 0); cutoff >= 0 {
+		version = version[:cutoff]
+	}
+	return string(version), nil
+}
+
+// ReadVTableFixups reads the VTable fixups referenced by the CLR header.
+// They are only present in mixed-mode images that export managed methods
+// to unmanaged code.
+func (r *Reader) ReadVTableFixups(header Header) ([]VTableFixup, error) {
+	if header.VTableFixups.IsZero() {
+		return nil, nil
+	}
+	data, err := r.pe.ReadRange(header.VTableFixups.Location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the VTable fixups: %w", err)
+	}
+
+	count := len(data) / vtableFixupSize
+	fixups := make([]VTableFixup, 0, count)
+	for i := range count {
+		address, slots, t := parseVTableFixup(data[i*vtableFixupSize : (i+1)*vtableFixupSize])
+		addressRange := imagefile.RelativeVirtualAddressRange{
+			Start:  address,
+			Length: uint(slots) * t.SlotSize(),
+		}
+		ok, location := r.pe.Sections().TranslateRange(addressRange)
+		if !ok {
+			return nil, fmt.Errorf("VTable fixup %d has a virtual address range (%s) that is not mapped to any section within the image file", i, addressRange)
+		}
+		fixups = append(fixups, VTableFixup{
+			Address:  address,
+			Count:    slots,
+			Type:     t,
+			Location: location,
+		})
+	}
+	return fixups, nil
+}
+
+// ReadVTableTokens returns the metadata tokens of the methods held by
+// the slots of the given VTable fixup.
+func (r *Reader) ReadVTableTokens(fixup VTableFixup) ([]uint32, error) {
+	data, err := r.pe.ReadRange(fixup.Location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the VTable at %s: %w", fixup.Address, err)
+	}
+	size := int(fixup.Type.SlotSize())
+	tokens := make([]uint32, 0, fixup.Count)
+	for i := 0; i+size <= len(data); i += size {
+		tokens = append(tokens, binary.LittleEndian.Uint32(data[i:i+4]))
+	}
+	return tokens, nil
+}
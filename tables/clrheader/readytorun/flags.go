@@ -0,0 +1,66 @@
+package readytorun
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flags holds flags that describe a ReadyToRun image.
+type Flags uint32
+
+// ReadyToRun flags.
+const (
+	FlagPlatformNeutralSource    Flags = 0x00000001 // READYTORUN_FLAG_PLATFORM_NEUTRAL_SOURCE
+	FlagSkipTypeValidation       Flags = 0x00000002 // READYTORUN_FLAG_SKIP_TYPE_VALIDATION
+	FlagPartial                  Flags = 0x00000004 // READYTORUN_FLAG_PARTIAL
+	FlagNonSharedPInvokeStubs    Flags = 0x00000008 // READYTORUN_FLAG_NONSHARED_PINVOKE_STUBS
+	FlagEmbeddedMSIL             Flags = 0x00000010 // READYTORUN_FLAG_EMBEDDED_MSIL
+	FlagComponent                Flags = 0x00000020 // READYTORUN_FLAG_COMPONENT
+	FlagMultiModuleVersionBubble Flags = 0x00000040 // READYTORUN_FLAG_MULTIMODULE_VERSION_BUBBLE
+	FlagUnrelatedR2RCode         Flags = 0x00000080 // READYTORUN_FLAG_UNRELATED_R2R_CODE
+)
+
+var flagNames = []struct {
+	flag Flags
+	name string
+}{
+	{FlagPlatformNeutralSource, "PLATFORM_NEUTRAL_SOURCE"},
+	{FlagSkipTypeValidation, "SKIP_TYPE_VALIDATION"},
+	{FlagPartial, "PARTIAL"},
+	{FlagNonSharedPInvokeStubs, "NONSHARED_PINVOKE_STUBS"},
+	{FlagEmbeddedMSIL, "EMBEDDED_MSIL"},
+	{FlagComponent, "COMPONENT"},
+	{FlagMultiModuleVersionBubble, "MULTIMODULE_VERSION_BUBBLE"},
+	{FlagUnrelatedR2RCode, "UNRELATED_R2R_CODE"},
+}
+
+// Component returns true if the image is a component of a composite image,
+// which holds its native code.
+func (flags Flags) Component() bool {
+	return flags&FlagComponent != 0
+}
+
+// Partial returns true if only some of the methods of the image were
+// compiled.
+func (flags Flags) Partial() bool {
+	return flags&FlagPartial != 0
+}
+
+// String returns a string representation of the flags.
+func (flags Flags) String() string {
+	var names []string
+	remaining := flags
+	for _, entry := range flagNames {
+		if flags&entry.flag != 0 {
+			names = append(names, entry.name)
+			remaining &^= entry.flag
+		}
+	}
+	if remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(remaining)))
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, " | ")
+}
@@ -0,0 +1,87 @@
+package readytorun
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+)
+
+// importSectionSize is the size of a READYTORUN_IMPORT_SECTION structure.
+const importSectionSize = 20
+
+// ImportSectionFlags holds flags that describe an import section.
+type ImportSectionFlags uint16
+
+// Import section flags.
+const (
+	ImportEager ImportSectionFlags = 0x0001 // ReadyToRunImportSectionFlags::Eager
+	ImportPCode ImportSectionFlags = 0x0004 // ReadyToRunImportSectionFlags::PCode
+)
+
+// Eager returns true if the imports are resolved when the image is
+// loaded, rather than when they are first used.
+func (flags ImportSectionFlags) Eager() bool {
+	return flags&ImportEager != 0
+}
+
+// PCode returns true if the imports are pointers to code.
+func (flags ImportSectionFlags) PCode() bool {
+	return flags&ImportPCode != 0
+}
+
+// ImportSectionType identifies the kind of imports held by an import
+// section.
+type ImportSectionType uint8
+
+// Import section types.
+const (
+	ImportUnknown      ImportSectionType = 0 // ReadyToRunImportSectionType::Unknown
+	ImportStubDispatch ImportSectionType = 2 // ReadyToRunImportSectionType::StubDispatch
+	ImportStringHandle ImportSectionType = 3 // ReadyToRunImportSectionType::StringHandle
+	ImportILBodyFixups ImportSectionType = 7 // ReadyToRunImportSectionType::ILBodyFixups
+)
+
+// String returns a string representation of the import section type.
+func (t ImportSectionType) String() string {
+	switch t {
+	case ImportUnknown:
+		return "Unknown"
+	case ImportStubDispatch:
+		return "StubDispatch"
+	case ImportStringHandle:
+		return "StringHandle"
+	case ImportILBodyFixups:
+		return "ILBodyFixups"
+	default:
+		return fmt.Sprintf("<unrecognized import section type: %d>", uint8(t))
+	}
+}
+
+// ImportSection describes a table of cells that are filled in by the
+// runtime, such as references to methods, types and strings that are
+// resolved when the image is loaded or when they are first used.
+type ImportSection struct {
+	// Cells is the location of the cells that are filled in.
+	Cells clrheader.Directory
+
+	Flags     ImportSectionFlags
+	Type      ImportSectionType
+	EntrySize uint8 // The size of each cell
+
+	// Signatures is the address of an array of addresses of signatures
+	// that describe each cell, or zero if the section doesn't have them.
+	Signatures imagefile.RelativeVirtualAddress
+
+	// AuxiliaryData is the address of additional data for the section,
+	// such as GC information, or zero if it doesn't have any.
+	AuxiliaryData imagefile.RelativeVirtualAddress
+}
+
+// Entries returns the number of cells in the import section.
+func (section ImportSection) Entries() int {
+	if section.EntrySize == 0 {
+		return 0
+	}
+	return int(section.Cells.Address.Length) / int(section.EntrySize)
+}
@@ -0,0 +1,254 @@
+package readytorun
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+)
+
+// ErrMissingHeader is returned by [NewReader] if the CLR header doesn't
+// reference a ReadyToRun header.
+var ErrMissingHeader = errors.New("the CLR header does not reference a ReadyToRun header")
+
+// Signature is the signature at the start of a ReadyToRun header, which
+// spells "RTR".
+const Signature = 0x00525452
+
+// headerSize is the size of a READYTORUN_HEADER structure, which is
+// followed by the section directory.
+const headerSize = 16
+
+// sectionSize is the size of a READYTORUN_SECTION structure.
+const sectionSize = 12
+
+// Header is a ReadyToRun header, which describes the native code that was
+// compiled ahead of time for a managed image. It is the READYTORUN_HEADER
+// structure.
+type Header struct {
+	MajorVersion uint16
+	MinorVersion uint16
+	Flags        Flags
+	Sections     []Section
+}
+
+// Section returns the section with the given type. It returns false if
+// the image doesn't have a section with that type.
+func (header Header) Section(t SectionType) (Section, bool) {
+	for _, section := range header.Sections {
+		if section.Type == t {
+			return section, true
+		}
+	}
+	return Section{}, false
+}
+
+// Composite returns true if the header belongs to a composite image,
+// which holds the native code of several component assemblies.
+func (header Header) Composite() bool {
+	_, ok := header.Section(SectionComponentAssemblies)
+	return ok
+}
+
+// Reader reads the ReadyToRun header of a managed image file, and the
+// sections that it refers to.
+type Reader struct {
+	clr     *clrheader.Reader
+	header  Header
+	machine imagefile.Machine
+	os      clrheader.OperatingSystem
+}
+
+// NewReader reads the ReadyToRun header that is referenced by the CLR
+// header read by clr, and returns a [Reader] for it. It returns
+// [ErrMissingHeader] if the image is not a ReadyToRun image.
+func NewReader(clr *clrheader.Reader) (*Reader, error) {
+	cor, err := clr.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+	if cor.ManagedNativeHeader.IsZero() {
+		return nil, ErrMissingHeader
+	}
+
+	r := &Reader{clr: clr}
+	r.machine, r.os, _ = clrheader.SplitMachine(clr.PE().Machine())
+
+	address := cor.ManagedNativeHeader.Address.Start
+	data, err := r.readAddress(address, headerSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the ReadyToRun header: %w", err)
+	}
+	if binary.LittleEndian.Uint32(data[0:4]) != Signature {
+		return nil, ErrMissingHeader
+	}
+	r.header = Header{
+		MajorVersion: binary.LittleEndian.Uint16(data[4:6]),
+		MinorVersion: binary.LittleEndian.Uint16(data[6:8]),
+		Flags:        Flags(binary.LittleEndian.Uint32(data[8:12])),
+	}
+
+	// The section directory immediately follows the header.
+	count := binary.LittleEndian.Uint32(data[12:16])
+	if count > 1024 {
+		return nil, fmt.Errorf("the ReadyToRun header has an invalid number of sections: %d", count)
+	}
+	if count == 0 {
+		return r, nil
+	}
+	data, err = r.readAddress(address+headerSize, uint(count)*sectionSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the ReadyToRun section directory: %w", err)
+	}
+	for i := range int(count) {
+		entry := data[i*sectionSize : (i+1)*sectionSize]
+		section := Section{Type: SectionType(binary.LittleEndian.Uint32(entry[0:4]))}
+		if section.Directory, err = r.directory(entry[4:12]); err != nil {
+			return nil, fmt.Errorf("ReadyToRun section %s: %w", section.Type, err)
+		}
+		r.header.Sections = append(r.header.Sections, section)
+	}
+
+	return r, nil
+}
+
+// Header returns the ReadyToRun header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Machine returns the machine targeted by the native code of the image.
+func (r *Reader) Machine() imagefile.Machine {
+	return r.machine
+}
+
+// OperatingSystem returns the operating system targeted by the native
+// code of the image.
+func (r *Reader) OperatingSystem() clrheader.OperatingSystem {
+	return r.os
+}
+
+// ReadSection reads the data of the given section.
+func (r *Reader) ReadSection(section Section) ([]byte, error) {
+	return r.clr.ReadData(section.Directory)
+}
+
+// ReadCompilerIdentifier returns the name and version of the compiler
+// that produced the native code, such as "Crossgen2 8.0.20". It returns
+// an empty string if the image doesn't identify its compiler.
+func (r *Reader) ReadCompilerIdentifier() (string, error) {
+	return r.readString(SectionCompilerIdentifier)
+}
+
+// ReadOwnerCompositeExecutable returns the file name of the composite image
+// that holds the native code of a component assembly. It returns an empty
+// string if the image is not a component of a composite image.
+func (r *Reader) ReadOwnerCompositeExecutable() (string, error) {
+	return r.readString(SectionOwnerCompositeExecutable)
+}
+
+// ReadImportSections reads the import sections of the image.
+func (r *Reader) ReadImportSections() ([]ImportSection, error) {
+	section, ok := r.header.Section(SectionImportSections)
+	if !ok {
+		return nil, nil
+	}
+	data, err := r.ReadSection(section)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the import sections: %w", err)
+	}
+	imports := make([]ImportSection, 0, len(data)/importSectionSize)
+	for i := 0; i+importSectionSize <= len(data); i += importSectionSize {
+		entry := data[i : i+importSectionSize]
+		cells, err := r.directory(entry[0:8])
+		if err != nil {
+			return nil, fmt.Errorf("import section %d: %w", len(imports), err)
+		}
+		imports = append(imports, ImportSection{
+			Cells:         cells,
+			Flags:         ImportSectionFlags(binary.LittleEndian.Uint16(entry[8:10])),
+			Type:          ImportSectionType(entry[10]),
+			EntrySize:     entry[11],
+			Signatures:    imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(entry[12:16])),
+			AuxiliaryData: imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(entry[16:20])),
+		})
+	}
+	return imports, nil
+}
+
+// ReadRuntimeFunctions reads the runtime functions of the image, which
+// describe the native code that was compiled ahead of time.
+func (r *Reader) ReadRuntimeFunctions() ([]RuntimeFunction, error) {
+	section, ok := r.header.Section(SectionRuntimeFunctions)
+	if !ok {
+		return nil, nil
+	}
+	data, err := r.ReadSection(section)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the runtime functions: %w", err)
+	}
+	size := runtimeFunctionSize(r.machine)
+	functions := make([]RuntimeFunction, 0, len(data)/size)
+	for i := 0; i+size <= len(data); i += size {
+		var function RuntimeFunction
+		function.Begin = imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(data[i : i+4]))
+		if size == 12 {
+			function.End = imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(data[i+4 : i+8]))
+		}
+		function.UnwindData = imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(data[i+size-4 : i+size]))
+		functions = append(functions, function)
+	}
+	return functions, nil
+}
+
+// readString reads the null-terminated string held by the section with
+// the given type. It returns an empty string if the section is missing.
+func (r *Reader) readString(t SectionType) (string, error) {
+	section, ok := r.header.Section(t)
+	if !ok {
+		return "", nil
+	}
+	data, err := r.ReadSection(section)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the %s section: %w", t, err)
+	}
+	if cutoff := bytes.IndexByte(data, 0); cutoff >= 0 {
+		data = data[:cutoff]
+	}
+	return string(data), nil
+}
+
+// directory parses an IMAGE_DATA_DIRECTORY structure and determines the
+// location of the data that it refers to.
+func (r *Reader) directory(data []byte) (clrheader.Directory, error) {
+	dir := clrheader.Directory{
+		Address: imagefile.RelativeVirtualAddressRange{
+			Start:  imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(data[0:4])),
+			Length: uint(binary.LittleEndian.Uint32(data[4:8])),
+		},
+	}
+	if dir.Address.Length == 0 {
+		// Empty sections may be placed at the end of an image section,
+		// where their address is not mapped.
+		return dir, nil
+	}
+	ok, location := r.clr.PE().Sections().TranslateRange(dir.Address)
+	if !ok {
+		return clrheader.Directory{}, fmt.Errorf("the virtual address range (%s) is not mapped to any section within the image file", dir.Address)
+	}
+	dir.Location = location
+	return dir, nil
+}
+
+// readAddress reads the data at the given relative virtual address.
+func (r *Reader) readAddress(address imagefile.RelativeVirtualAddress, length uint) ([]byte, error) {
+	addressRange := imagefile.RelativeVirtualAddressRange{Start: address, Length: length}
+	ok, location := r.clr.PE().Sections().TranslateRange(addressRange)
+	if !ok {
+		return nil, fmt.Errorf("the virtual address range (%s) is not mapped to any section within the image file", addressRange)
+	}
+	return r.clr.PE().ReadRange(location)
+}
@@ -0,0 +1,26 @@
+package readytorun
+
+import "github.com/gentlemanautomaton/portableexecutable/imagefile"
+
+// RuntimeFunction describes the native code of a method, or of a part of
+// a method such as a funclet, that was compiled ahead of time.
+type RuntimeFunction struct {
+	Begin imagefile.RelativeVirtualAddress
+
+	// End is the address that follows the code. It is zero for machines
+	// that don't record it, which is all but x64.
+	End imagefile.RelativeVirtualAddress
+
+	// UnwindData is the address of the information that describes how to
+	// unwind the stack frame of the code.
+	UnwindData imagefile.RelativeVirtualAddress
+}
+
+// runtimeFunctionSize returns the size of a RUNTIME_FUNCTION structure for
+// the given machine.
+func runtimeFunctionSize(machine imagefile.Machine) int {
+	if machine == imagefile.MachineAMD64 {
+		return 12
+	}
+	return 8
+}
@@ -0,0 +1,97 @@
+package readytorun
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+)
+
+// SectionType identifies the content of a section of a ReadyToRun image.
+type SectionType uint32
+
+// ReadyToRun section types.
+const (
+	SectionCompilerIdentifier        SectionType = 100 // ReadyToRunSectionType::CompilerIdentifier
+	SectionImportSections            SectionType = 101 // ReadyToRunSectionType::ImportSections
+	SectionRuntimeFunctions          SectionType = 102 // ReadyToRunSectionType::RuntimeFunctions
+	SectionMethodDefEntryPoints      SectionType = 103 // ReadyToRunSectionType::MethodDefEntryPoints
+	SectionExceptionInfo             SectionType = 104 // ReadyToRunSectionType::ExceptionInfo
+	SectionDebugInfo                 SectionType = 105 // ReadyToRunSectionType::DebugInfo
+	SectionDelayLoadMethodCallThunks SectionType = 106 // ReadyToRunSectionType::DelayLoadMethodCallThunks
+	SectionAvailableTypes            SectionType = 108 // ReadyToRunSectionType::AvailableTypes
+	SectionInstanceMethodEntryPoints SectionType = 109 // ReadyToRunSectionType::InstanceMethodEntryPoints
+	SectionInliningInfo              SectionType = 110 // ReadyToRunSectionType::InliningInfo
+	SectionProfileDataInfo           SectionType = 111 // ReadyToRunSectionType::ProfileDataInfo
+	SectionManifestMetadata          SectionType = 112 // ReadyToRunSectionType::ManifestMetadata
+	SectionAttributePresence         SectionType = 113 // ReadyToRunSectionType::AttributePresence
+	SectionInliningInfo2             SectionType = 114 // ReadyToRunSectionType::InliningInfo2
+	SectionComponentAssemblies       SectionType = 115 // ReadyToRunSectionType::ComponentAssemblies
+	SectionOwnerCompositeExecutable  SectionType = 116 // ReadyToRunSectionType::OwnerCompositeExecutable
+	SectionPgoInstrumentationData    SectionType = 117 // ReadyToRunSectionType::PgoInstrumentationData
+	SectionManifestAssemblyMvids     SectionType = 118 // ReadyToRunSectionType::ManifestAssemblyMvids
+	SectionCrossModuleInlineInfo     SectionType = 119 // ReadyToRunSectionType::CrossModuleInlineInfo
+	SectionHotColdMap                SectionType = 120 // ReadyToRunSectionType::HotColdMap
+	SectionMethodIsGenericMap        SectionType = 121 // ReadyToRunSectionType::MethodIsGenericMap
+	SectionEnclosingTypeMap          SectionType = 122 // ReadyToRunSectionType::EnclosingTypeMap
+	SectionTypeGenericInfoMap        SectionType = 123 // ReadyToRunSectionType::TypeGenericInfoMap
+)
+
+// String returns a string representation of the section type.
+func (t SectionType) String() string {
+	switch t {
+	case SectionCompilerIdentifier:
+		return "CompilerIdentifier"
+	case SectionImportSections:
+		return "ImportSections"
+	case SectionRuntimeFunctions:
+		return "RuntimeFunctions"
+	case SectionMethodDefEntryPoints:
+		return "MethodDefEntryPoints"
+	case SectionExceptionInfo:
+		return "ExceptionInfo"
+	case SectionDebugInfo:
+		return "DebugInfo"
+	case SectionDelayLoadMethodCallThunks:
+		return "DelayLoadMethodCallThunks"
+	case SectionAvailableTypes:
+		return "AvailableTypes"
+	case SectionInstanceMethodEntryPoints:
+		return "InstanceMethodEntryPoints"
+	case SectionInliningInfo:
+		return "InliningInfo"
+	case SectionProfileDataInfo:
+		return "ProfileDataInfo"
+	case SectionManifestMetadata:
+		return "ManifestMetadata"
+	case SectionAttributePresence:
+		return "AttributePresence"
+	case SectionInliningInfo2:
+		return "InliningInfo2"
+	case SectionComponentAssemblies:
+		return "ComponentAssemblies"
+	case SectionOwnerCompositeExecutable:
+		return "OwnerCompositeExecutable"
+	case SectionPgoInstrumentationData:
+		return "PgoInstrumentationData"
+	case SectionManifestAssemblyMvids:
+		return "ManifestAssemblyMvids"
+	case SectionCrossModuleInlineInfo:
+		return "CrossModuleInlineInfo"
+	case SectionHotColdMap:
+		return "HotColdMap"
+	case SectionMethodIsGenericMap:
+		return "MethodIsGenericMap"
+	case SectionEnclosingTypeMap:
+		return "EnclosingTypeMap"
+	case SectionTypeGenericInfoMap:
+		return "TypeGenericInfoMap"
+	default:
+		return fmt.Sprintf("<unrecognized ReadyToRun section type: %d>", uint32(t))
+	}
+}
+
+// Section is an entry in the section directory of a ReadyToRun header.
+type Section struct {
+	Type SectionType
+	clrheader.Directory
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strings"
+)
+
+// Decimal is a System.Decimal value, which is a 96-bit integer that is
+// scaled by a power of 10.
+type Decimal [16]byte
+
+// Scale returns the power of 10 that the integer is divided by.
+func (d Decimal) Scale() int {
+	return int(d[14])
+}
+
+// Negative returns true if the value is negative.
+func (d Decimal) Negative() bool {
+	return d[15]&0x80 != 0
+}
+
+// String returns a string representation of the value, including any
+// trailing zeros implied by its scale.
+func (d Decimal) String() string {
+	// The integer is stored as its low, middle and high 32-bit words,
+	// followed by the flags that hold the scale and sign.
+	var magnitude big.Int
+	for i := 8; i >= 0; i -= 4 {
+		magnitude.Lsh(&magnitude, 32)
+		magnitude.Or(&magnitude, new(big.Int).SetUint64(uint64(binary.LittleEndian.Uint32(d[i:i+4]))))
+	}
+
+	digits := magnitude.String()
+	if scale := d.Scale(); scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if d.Negative() {
+		digits = "-" + digits
+	}
+	return digits
+}
@@ -0,0 +1,447 @@
+package resources
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Signature is the signature at the start of a .resources file.
+const Signature = 0xBEEFCACE
+
+// HasSignature returns true if data starts with the signature of a
+// .resources file.
+func HasSignature(data []byte) bool {
+	return len(data) >= 4 && binary.LittleEndian.Uint32(data) == Signature
+}
+
+// Set is the content of a .resources file, which is the binary format
+// produced by System.Resources.ResourceWriter and commonly embedded in
+// managed assemblies.
+type Set struct {
+	// ReaderType is the name of the type that is used to read the
+	// resources, such as System.Resources.ResourceReader.
+	ReaderType string
+
+	// SetType is the name of the type of the resource set.
+	SetType string
+
+	// Version is the version of the resource set format, which is 1 or 2.
+	Version int
+
+	// Types holds the names of the types of the values within the set.
+	Types []string
+
+	Entries []Entry
+}
+
+// Entry is a named value within a resource set.
+type Entry struct {
+	Name string
+
+	// Type is the type of the value. For user types, the name of the type
+	// is held by TypeName.
+	Type TypeCode
+
+	// TypeName is the name of the type of the value. It is empty for
+	// version 2 values that have a primitive type.
+	TypeName string
+
+	// Value holds the value of the entry:
+	//
+	//   - nil for TypeNull
+	//   - string for TypeString
+	//   - bool for TypeBoolean
+	//   - rune for TypeChar, which must be distinguished from int32 by
+	//     its Type
+	//   - uint8, int8, int16, uint16, int32, uint32, int64 or uint64 for
+	//     integers
+	//   - float32 or float64 for floating point numbers
+	//   - Decimal for TypeDecimal
+	//   - time.Time for TypeDateTime
+	//   - time.Duration for TypeTimeSpan
+	//   - []byte for TypeByteArray and TypeStream, and for user types,
+	//     which hold the data from which the value is deserialized
+	Value any
+}
+
+// Parse parses the .resources file in data.
+func Parse(data []byte) (Set, error) {
+	r := setReader{data: data}
+
+	// The resource manager header identifies the reader and set types.
+	if !HasSignature(data) {
+		return Set{}, fmt.Errorf("the resources do not have the expected signature")
+	}
+	r.pos = 4
+	headerVersion, err := r.int32()
+	if err != nil {
+		return Set{}, err
+	}
+	headerSize, err := r.int32()
+	if err != nil {
+		return Set{}, err
+	}
+	var set Set
+	if headerVersion > 1 {
+		if err := r.skip(int(headerSize)); err != nil {
+			return Set{}, err
+		}
+	} else {
+		if set.ReaderType, err = r.string(); err != nil {
+			return Set{}, err
+		}
+		if set.SetType, err = r.string(); err != nil {
+			return Set{}, err
+		}
+	}
+
+	// The resource set header describes the resources and their types.
+	version, err := r.int32()
+	if err != nil {
+		return Set{}, err
+	}
+	if version != 1 && version != 2 {
+		return Set{}, fmt.Errorf("the resources have an unsupported version of %d", version)
+	}
+	set.Version = int(version)
+	count, err := r.count()
+	if err != nil {
+		return Set{}, err
+	}
+	types, err := r.count()
+	if err != nil {
+		return Set{}, err
+	}
+	set.Types = make([]string, 0, types)
+	for range types {
+		name, err := r.string()
+		if err != nil {
+			return Set{}, err
+		}
+		set.Types = append(set.Types, name)
+	}
+
+	// The name hashes are aligned to 8 bytes with padding that spells
+	// "PAD".
+	if err := r.skip((8 - r.pos%8) % 8); err != nil {
+		return Set{}, err
+	}
+	if err := r.skip(count * 4); err != nil {
+		return Set{}, err
+	}
+	positions := make([]int, count)
+	for i := range positions {
+		position, err := r.int32()
+		if err != nil {
+			return Set{}, err
+		}
+		positions[i] = int(position)
+	}
+	dataSection, err := r.int32()
+	if err != nil {
+		return Set{}, err
+	}
+	nameSection := r.pos
+	if dataSection < 0 || int(dataSection) < nameSection || int(dataSection) > len(data) {
+		return Set{}, fmt.Errorf("the resources have a data section offset of %d that is invalid", dataSection)
+	}
+
+	// Read the name and data offset of each entry.
+	set.Entries = make([]Entry, count)
+	offsets := make([]int, count)
+	for i, position := range positions {
+		if position < 0 || nameSection+position >= int(dataSection) {
+			return Set{}, fmt.Errorf("resource %d has a name offset of %d that is invalid", i, position)
+		}
+		r.pos = nameSection + position
+		if set.Entries[i].Name, err = r.utf16String(); err != nil {
+			return Set{}, fmt.Errorf("failed to read the name of resource %d: %w", i, err)
+		}
+		offset, err := r.int32()
+		if err != nil {
+			return Set{}, err
+		}
+		if offset < 0 || int(dataSection)+int(offset) >= len(data) {
+			return Set{}, fmt.Errorf("resource \"%s\" has a data offset of %d that is invalid", set.Entries[i].Name, offset)
+		}
+		offsets[i] = int(dataSection) + int(offset)
+	}
+
+	// The data of user types is not prefixed by its length, so it is
+	// bounded by the start of the next value.
+	ends := make(map[int]int, count)
+	sorted := append([]int(nil), offsets...)
+	sort.Ints(sorted)
+	for i, offset := range sorted {
+		if i+1 < len(sorted) {
+			ends[offset] = sorted[i+1]
+		} else {
+			ends[offset] = len(data)
+		}
+	}
+
+	for i := range set.Entries {
+		entry := &set.Entries[i]
+		r.pos = offsets[i]
+		r.end = ends[offsets[i]]
+		if set.Version == 1 {
+			err = r.entryV1(&set, entry)
+		} else {
+			err = r.entryV2(&set, entry)
+		}
+		r.end = 0
+		if err != nil {
+			return Set{}, fmt.Errorf("failed to read the value of resource \"%s\": %w", entry.Name, err)
+		}
+	}
+
+	return set, nil
+}
+
+// setReader reads the components of a .resources file.
+type setReader struct {
+	data []byte
+	pos  int
+	end  int // The end of the current value, which bounds user type data
+}
+
+func (r *setReader) take(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("the resources are truncated")
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *setReader) skip(n int) error {
+	_, err := r.take(n)
+	return err
+}
+
+func (r *setReader) int32() (int32, error) {
+	b, err := r.take(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(b)), nil
+}
+
+// count reads a number of items, each of which must occupy at least one
+// byte of the remaining data.
+func (r *setReader) count() (int, error) {
+	n, err := r.int32()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || int(n) > len(r.data)-r.pos {
+		return 0, fmt.Errorf("the resources have an invalid count of %d", n)
+	}
+	return int(n), nil
+}
+
+// uint7 reads an integer that is encoded 7 bits at a time.
+func (r *setReader) uint7() (uint32, error) {
+	var value uint32
+	for shift := 0; shift < 35; shift += 7 {
+		b, err := r.take(1)
+		if err != nil {
+			return 0, err
+		}
+		value |= uint32(b[0]&0x7F) << shift
+		if b[0]&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("the resources have an invalid 7-bit encoded integer")
+}
+
+// string reads a UTF-8 string that is prefixed by its length.
+func (r *setReader) string() (string, error) {
+	length, err := r.uint7()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.take(int(min(length, math.MaxInt32)))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// utf16String reads a UTF-16 string that is prefixed by its length in
+// bytes.
+func (r *setReader) utf16String() (string, error) {
+	length, err := r.uint7()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.take(int(min(length, math.MaxInt32)))
+	if err != nil {
+		return "", err
+	}
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// entryV2 reads a value that is prefixed by its type code.
+func (r *setReader) entryV2(set *Set, entry *Entry) error {
+	code, err := r.uint7()
+	if err != nil {
+		return err
+	}
+	entry.Type = TypeCode(code)
+	if entry.Type.IsUser() {
+		index := int(entry.Type - TypeUser)
+		if index >= len(set.Types) {
+			return fmt.Errorf("the value has a type code of %d that refers to a missing type", code)
+		}
+		entry.TypeName = set.Types[index]
+		entry.Value, err = r.take(r.end - r.pos)
+		return err
+	}
+	entry.Value, err = r.value(entry.Type, false)
+	return err
+}
+
+// entryV1 reads a value that is prefixed by the index of its type.
+func (r *setReader) entryV1(set *Set, entry *Entry) error {
+	index, err := r.uint7()
+	if err != nil {
+		return err
+	}
+	if int32(index) == -1 {
+		entry.Type = TypeNull
+		return nil
+	}
+	if int(index) >= len(set.Types) {
+		return fmt.Errorf("the value has a type index of %d that refers to a missing type", index)
+	}
+	entry.TypeName = set.Types[index]
+
+	// Primitive values are identified by the names of their types, which
+	// may be qualified by the names of their assemblies.
+	name, _, _ := strings.Cut(entry.TypeName, ",")
+	code, ok := typeNames[name]
+	if !ok {
+		entry.Type = TypeUser + TypeCode(index)
+		entry.Value, err = r.take(r.end - r.pos)
+		return err
+	}
+	entry.Type = code
+	entry.Value, err = r.value(code, true)
+	return err
+}
+
+// value reads a value with the given primitive type. In version 1 of the
+// format, dates are recorded as ticks rather than in their binary form.
+func (r *setReader) value(code TypeCode, v1 bool) (any, error) {
+	var size int
+	switch code {
+	case TypeNull:
+		return nil, nil
+	case TypeString:
+		return r.string()
+	case TypeByteArray, TypeStream:
+		length, err := r.int32()
+		if err != nil {
+			return nil, err
+		}
+		return r.take(int(length))
+	case TypeBoolean, TypeByte, TypeSByte:
+		size = 1
+	case TypeChar, TypeInt16, TypeUInt16:
+		size = 2
+	case TypeInt32, TypeUInt32, TypeSingle:
+		size = 4
+	case TypeInt64, TypeUInt64, TypeDouble, TypeDateTime, TypeTimeSpan:
+		size = 8
+	case TypeDecimal:
+		size = 16
+	default:
+		return nil, fmt.Errorf("the value has an unrecognized type code of %d", uint32(code))
+	}
+
+	b, err := r.take(size)
+	if err != nil {
+		return nil, err
+	}
+	switch code {
+	case TypeBoolean:
+		return b[0] != 0, nil
+	case TypeByte:
+		return b[0], nil
+	case TypeSByte:
+		return int8(b[0]), nil
+	case TypeChar:
+		return rune(binary.LittleEndian.Uint16(b)), nil
+	case TypeInt16:
+		return int16(binary.LittleEndian.Uint16(b)), nil
+	case TypeUInt16:
+		return binary.LittleEndian.Uint16(b), nil
+	case TypeInt32:
+		return int32(binary.LittleEndian.Uint32(b)), nil
+	case TypeUInt32:
+		return binary.LittleEndian.Uint32(b), nil
+	case TypeSingle:
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case TypeInt64:
+		return int64(binary.LittleEndian.Uint64(b)), nil
+	case TypeUInt64:
+		return binary.LittleEndian.Uint64(b), nil
+	case TypeDouble:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case TypeDecimal:
+		return Decimal(b), nil
+	case TypeTimeSpan:
+		return time.Duration(int64(binary.LittleEndian.Uint64(b)) * 100), nil
+	default:
+		value := binary.LittleEndian.Uint64(b)
+		if v1 {
+			return dateFromTicks(int64(value)), nil
+		}
+		return dateFromBinary(value), nil
+	}
+}
+
+// ticksPerSecond is the number of 100-nanosecond ticks in a second.
+const ticksPerSecond = 10_000_000
+
+// unixEpochTicks is the number of ticks between January 1 of year 1 and
+// the Unix epoch.
+const unixEpochTicks = 621_355_968_000_000_000
+
+// dateFromTicks converts a number of ticks since January 1 of year 1 to
+// a time.
+func dateFromTicks(ticks int64) time.Time {
+	ticks -= unixEpochTicks
+	seconds, remainder := ticks/ticksPerSecond, ticks%ticksPerSecond
+	if remainder < 0 {
+		seconds, remainder = seconds-1, remainder+ticksPerSecond
+	}
+	return time.Unix(seconds, remainder*100).UTC()
+}
+
+// dateFromBinary converts the binary form of a System.DateTime to a time.
+// The top two bits hold the kind of the time, and the remaining bits hold
+// its ticks. Local times are recorded in UTC.
+func dateFromBinary(value uint64) time.Time {
+	const (
+		ticksMask    = 0x3FFFFFFFFFFFFFFF
+		localKind    = 0x8000000000000000
+		ticksCeiling = 0x4000000000000000
+	)
+	ticks := int64(value & ticksMask)
+	if value&localKind != 0 && ticks > ticksCeiling-24*60*60*ticksPerSecond {
+		ticks -= ticksCeiling
+	}
+	return dateFromTicks(ticks)
+}
@@ -0,0 +1,109 @@
+package resources
+
+import "fmt"
+
+// TypeCode identifies the type of a resource value in version 2 of the
+// .resources format.
+type TypeCode uint32
+
+// Resource type codes.
+const (
+	TypeNull      TypeCode = 0x00 // ResourceTypeCode.Null
+	TypeString    TypeCode = 0x01 // ResourceTypeCode.String
+	TypeBoolean   TypeCode = 0x02 // ResourceTypeCode.Boolean
+	TypeChar      TypeCode = 0x03 // ResourceTypeCode.Char
+	TypeByte      TypeCode = 0x04 // ResourceTypeCode.Byte
+	TypeSByte     TypeCode = 0x05 // ResourceTypeCode.SByte
+	TypeInt16     TypeCode = 0x06 // ResourceTypeCode.Int16
+	TypeUInt16    TypeCode = 0x07 // ResourceTypeCode.UInt16
+	TypeInt32     TypeCode = 0x08 // ResourceTypeCode.Int32
+	TypeUInt32    TypeCode = 0x09 // ResourceTypeCode.UInt32
+	TypeInt64     TypeCode = 0x0A // ResourceTypeCode.Int64
+	TypeUInt64    TypeCode = 0x0B // ResourceTypeCode.UInt64
+	TypeSingle    TypeCode = 0x0C // ResourceTypeCode.Single
+	TypeDouble    TypeCode = 0x0D // ResourceTypeCode.Double
+	TypeDecimal   TypeCode = 0x0E // ResourceTypeCode.Decimal
+	TypeDateTime  TypeCode = 0x0F // ResourceTypeCode.DateTime
+	TypeTimeSpan  TypeCode = 0x10 // ResourceTypeCode.TimeSpan
+	TypeByteArray TypeCode = 0x20 // ResourceTypeCode.ByteArray
+	TypeStream    TypeCode = 0x21 // ResourceTypeCode.Stream
+
+	// TypeUser is the first type code for user types. The type code of
+	// a user type is TypeUser plus its index within the type table.
+	TypeUser TypeCode = 0x40 // ResourceTypeCode.StartOfUserTypes
+)
+
+// IsUser returns true if the type code refers to a user type.
+func (code TypeCode) IsUser() bool {
+	return code >= TypeUser
+}
+
+// String returns a string representation of the type code.
+func (code TypeCode) String() string {
+	switch code {
+	case TypeNull:
+		return "Null"
+	case TypeString:
+		return "String"
+	case TypeBoolean:
+		return "Boolean"
+	case TypeChar:
+		return "Char"
+	case TypeByte:
+		return "Byte"
+	case TypeSByte:
+		return "SByte"
+	case TypeInt16:
+		return "Int16"
+	case TypeUInt16:
+		return "UInt16"
+	case TypeInt32:
+		return "Int32"
+	case TypeUInt32:
+		return "UInt32"
+	case TypeInt64:
+		return "Int64"
+	case TypeUInt64:
+		return "UInt64"
+	case TypeSingle:
+		return "Single"
+	case TypeDouble:
+		return "Double"
+	case TypeDecimal:
+		return "Decimal"
+	case TypeDateTime:
+		return "DateTime"
+	case TypeTimeSpan:
+		return "TimeSpan"
+	case TypeByteArray:
+		return "ByteArray"
+	case TypeStream:
+		return "Stream"
+	default:
+		if code.IsUser() {
+			return fmt.Sprintf("User(%d)", uint32(code-TypeUser))
+		}
+		return fmt.Sprintf("<unrecognized resource type code: %x>", uint32(code))
+	}
+}
+
+// typeNames maps the names of the types that version 1 of the .resources
+// format records for primitive values to their type codes.
+var typeNames = map[string]TypeCode{
+	"System.String":   TypeString,
+	"System.Boolean":  TypeBoolean,
+	"System.Char":     TypeChar,
+	"System.Byte":     TypeByte,
+	"System.SByte":    TypeSByte,
+	"System.Int16":    TypeInt16,
+	"System.UInt16":   TypeUInt16,
+	"System.Int32":    TypeInt32,
+	"System.UInt32":   TypeUInt32,
+	"System.Int64":    TypeInt64,
+	"System.UInt64":   TypeUInt64,
+	"System.Single":   TypeSingle,
+	"System.Double":   TypeDouble,
+	"System.Decimal":  TypeDecimal,
+	"System.DateTime": TypeDateTime,
+	"System.TimeSpan": TypeTimeSpan,
+}
@@ -0,0 +1,76 @@
+package strongname
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Hash computes the strong name hash of the image file read by pe, using
+// the given hash function. The signature range identifies the location of
+// the strong name signature within the file.
+//
+// The hash covers the image headers and section table, followed by the
+// raw data of each section in the order of the section table. The image
+// checksum and the certificate table data directory entry are treated as
+// zero, and the strong name signature is excluded. Padding that follows
+// the section table, and any data that follows the last section, is not
+// included.
+func Hash(pe *portableexecutable.Reader, signature imagefile.FileRange, algorithm crypto.Hash) ([]byte, error) {
+	if !algorithm.Available() {
+		return nil, fmt.Errorf("the hash algorithm %s is not available", algorithm)
+	}
+
+	layout := pe.Layout()
+	table := layout.SectionTable()
+	headers, err := pe.ReadRange(imagefile.FileRange{Length: uint(table.Start) + table.Length})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the image headers: %w", err)
+	}
+	for _, excluded := range []imagefile.FileRange{
+		layout.CheckSum(),
+		layout.DataDirectoryEntry(pe.Format(), imagefile.CertificateTableID),
+	} {
+		if excluded.Length > 0 && uint(excluded.Start)+excluded.Length <= uint(len(headers)) {
+			clear(headers[excluded.Start : uint(excluded.Start)+excluded.Length])
+		}
+	}
+
+	h := algorithm.New()
+	h.Write(headers)
+
+	signatureEnd := signature.Start + imagefile.FileOffset(signature.Length)
+	for _, section := range pe.Sections() {
+		start := section.FileRange.Start
+		end := start + imagefile.FileOffset(section.FileRange.Length)
+		if signature.Length > 0 && signature.Start < end && signatureEnd > start {
+			if err := copyRange(h, pe.Source(), start, max(start, signature.Start)); err != nil {
+				return nil, err
+			}
+			start = min(end, signatureEnd)
+		}
+		if err := copyRange(h, pe.Source(), start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	return h.Sum(nil), nil
+}
+
+// copyRange copies the data from source between start and end to w.
+func copyRange(w io.Writer, source io.ReaderAt, start, end imagefile.FileOffset) error {
+	if start >= end {
+		return nil
+	}
+	n, err := io.Copy(w, io.NewSectionReader(source, int64(start), int64(end-start)))
+	if err == nil && n < int64(end-start) {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read image data at location %s: %w", imagefile.FileRange{Start: start, Length: uint(end - start)}, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package strongname
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+)
+
+// NeutralKey is the 16 byte ECMA neutral public key. Assemblies that
+// record it are signed with a platform key that the runtime substitutes
+// for it.
+var NeutralKey = []byte{0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0}
+
+// Public key blob values.
+const (
+	publicKeyHeaderSize = 12         // The size of the strong name public key header
+	publicKeyBlobSize   = 20         // The size of the PUBLICKEYSTRUC and RSAPUBKEY structures
+	publicKeyBlobType   = 0x06       // PUBLICKEYBLOB
+	publicKeyBlobMagic  = 0x31415352 // "RSA1"
+)
+
+// PublicKey is a strong name public key, as it is recorded by the
+// Assembly metadata table.
+type PublicKey struct {
+	// SignatureAlgorithm is the CryptoAPI ALG_ID of the signature
+	// algorithm, such as CALG_RSA_SIGN.
+	SignatureAlgorithm uint32
+
+	// HashAlgorithm is the algorithm that is used to compute the strong
+	// name hash.
+	HashAlgorithm metadata.HashAlgorithm
+
+	// Key is the RSA public key that verifies the signature.
+	Key *rsa.PublicKey
+}
+
+// ParsePublicKey parses a strong name public key blob, which holds a
+// CryptoAPI PUBLICKEYBLOB that is prefixed by the signature and hash
+// algorithms. It returns [ErrNeutralKey] if the blob holds the ECMA
+// neutral key.
+func ParsePublicKey(blob []byte) (PublicKey, error) {
+	if bytes.Equal(blob, NeutralKey) {
+		return PublicKey{}, ErrNeutralKey
+	}
+	if len(blob) < publicKeyHeaderSize+publicKeyBlobSize {
+		return PublicKey{}, fmt.Errorf("the public key is %d byte(s) long when at least %d bytes are required", len(blob), publicKeyHeaderSize+publicKeyBlobSize)
+	}
+
+	key := PublicKey{
+		SignatureAlgorithm: binary.LittleEndian.Uint32(blob[0:4]),
+		HashAlgorithm:      metadata.HashAlgorithm(binary.LittleEndian.Uint32(blob[4:8])),
+	}
+	if length := binary.LittleEndian.Uint32(blob[8:12]); uint64(length) != uint64(len(blob)-publicKeyHeaderSize) {
+		return PublicKey{}, fmt.Errorf("the public key has a length of %d bytes when %d bytes are present", length, len(blob)-publicKeyHeaderSize)
+	}
+
+	data := blob[publicKeyHeaderSize:]
+	if data[0] != publicKeyBlobType {
+		return PublicKey{}, fmt.Errorf("the public key has a blob type of 0x%x instead of a PUBLICKEYBLOB", data[0])
+	}
+	if magic := binary.LittleEndian.Uint32(data[8:12]); magic != publicKeyBlobMagic {
+		return PublicKey{}, fmt.Errorf("the public key does not hold an RSA public key")
+	}
+	bits := binary.LittleEndian.Uint32(data[12:16])
+	exponent := binary.LittleEndian.Uint32(data[16:20])
+	size := (uint64(bits) + 7) / 8
+	if size == 0 || size > uint64(len(data)-publicKeyBlobSize) {
+		return PublicKey{}, fmt.Errorf("the public key has a modulus of %d bits that exceeds the bounds of the blob", bits)
+	}
+	if exponent == 0 || exponent > 1<<31-1 {
+		return PublicKey{}, fmt.Errorf("the public key has an invalid exponent of %d", exponent)
+	}
+
+	// The modulus is stored in little-endian byte order.
+	modulus := slices.Clone(data[publicKeyBlobSize : publicKeyBlobSize+size])
+	slices.Reverse(modulus)
+	key.Key = &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(exponent),
+	}
+
+	return key, nil
+}
+
+// Hash returns the hash function that is used to compute the strong name
+// hash for the key. Keys that don't specify a hash algorithm use SHA-1.
+func (key PublicKey) Hash() (crypto.Hash, error) {
+	switch key.HashAlgorithm {
+	case metadata.HashNone, metadata.HashSHA1:
+		return crypto.SHA1, nil
+	case metadata.HashSHA256:
+		return crypto.SHA256, nil
+	case metadata.HashSHA384:
+		return crypto.SHA384, nil
+	case metadata.HashSHA512:
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("the public key uses the %s hash algorithm, which is not supported for strong name signatures", key.HashAlgorithm)
+	}
+}
@@ -0,0 +1,181 @@
+package strongname
+
+import (
+	"crypto/rsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	_ "crypto/sha1"   // Register SHA-1 for strong name hashes
+	_ "crypto/sha256" // Register SHA-256 for strong name hashes
+	_ "crypto/sha512" // Register SHA-384 and SHA-512 for strong name hashes
+
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader"
+	"github.com/gentlemanautomaton/portableexecutable/tables/clrheader/metadata"
+)
+
+var (
+	// ErrNotSigned is returned when the CLR header doesn't reference a
+	// strong name signature.
+	ErrNotSigned = errors.New("the assembly does not have a strong name signature")
+
+	// ErrDelaySigned is returned when the CLR header reserves space for a
+	// strong name signature that hasn't been computed yet.
+	ErrDelaySigned = errors.New("the assembly is delay signed")
+
+	// ErrPublicSigned is returned when the CLR header claims that the
+	// image is strong name signed but the signature is empty. Public
+	// signing gives an assembly a strong name identity without signing it.
+	ErrPublicSigned = errors.New("the assembly is public signed")
+
+	// ErrMissingPublicKey is returned when the assembly doesn't record a
+	// public key that can verify its strong name signature.
+	ErrMissingPublicKey = errors.New("the assembly does not have a public key")
+
+	// ErrNeutralKey is returned when the assembly records the ECMA neutral
+	// key, which can't be used to verify a signature.
+	ErrNeutralKey = errors.New("the assembly has the ECMA neutral public key")
+
+	// ErrInvalidSignature is returned when the strong name signature does
+	// not match the contents of the image.
+	ErrInvalidSignature = errors.New("the strong name signature is invalid")
+)
+
+// signatureKeyAttribute is the name of the AssemblySignatureKeyAttribute
+// type.
+var signatureKeyAttribute = metadata.TypeName{Namespace: "System.Reflection", Name: "AssemblySignatureKeyAttribute"}
+
+// SigningKey returns the public key blob that the assembly described by
+// md was signed with. This is the key given by its
+// AssemblySignatureKeyAttribute, if it has one, and otherwise the public
+// key recorded by its Assembly metadata table. It returns
+// [ErrMissingPublicKey] if the assembly doesn't have a public key.
+func SigningKey(md *metadata.Reader) ([]byte, error) {
+	attributes, err := md.CustomAttributes(metadata.NewToken(metadata.TableAssembly, 1))
+	if err != nil {
+		return nil, err
+	}
+	for _, attribute := range attributes {
+		name, err := md.AttributeType(attribute)
+		if err != nil {
+			return nil, err
+		}
+		if name != signatureKeyAttribute {
+			continue
+		}
+		value, err := md.DecodeCustomAttribute(attribute, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the signature key attribute: %w", err)
+		}
+		if len(value.Fixed) == 0 {
+			break
+		}
+		encoded, _ := value.Fixed[0].Value.(string)
+		if encoded == "" {
+			break
+		}
+		key, err := hex.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("the signature key attribute holds an invalid public key: %w", err)
+		}
+		return key, nil
+	}
+
+	name, err := md.AssemblyName()
+	if err != nil {
+		return nil, err
+	}
+	if len(name.PublicKey) == 0 {
+		return nil, ErrMissingPublicKey
+	}
+	return name.PublicKey, nil
+}
+
+// ReadSignature reads the strong name signature referenced by the CLR
+// header. It returns [ErrNotSigned] if the CLR header doesn't reference a
+// signature.
+func ReadSignature(clr *clrheader.Reader, header clrheader.Header) ([]byte, error) {
+	if header.StrongNameSignature.IsZero() {
+		return nil, ErrNotSigned
+	}
+	signature, err := clr.ReadData(header.StrongNameSignature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the strong name signature: %w", err)
+	}
+	return signature, nil
+}
+
+// Verify verifies the strong name signature of the managed image read by
+// clr against the key returned by [SigningKey]. It returns nil if the
+// signature is valid.
+//
+// It returns [ErrNotSigned] if the image doesn't have a strong name
+// signature, [ErrDelaySigned] or [ErrPublicSigned] if the signature hasn't
+// been computed, [ErrNeutralKey] if the image records the ECMA neutral key
+// and [ErrInvalidSignature] if the signature doesn't match.
+func Verify(clr *clrheader.Reader) error {
+	header, err := clr.ReadHeader()
+	if err != nil {
+		return err
+	}
+	if header.StrongNameSignature.IsZero() {
+		return ErrNotSigned
+	}
+	md, err := metadata.NewReader(clr)
+	if err != nil {
+		return err
+	}
+	blob, err := SigningKey(md)
+	if err != nil {
+		return err
+	}
+	key, err := ParsePublicKey(blob)
+	if err != nil {
+		return err
+	}
+	return VerifyKey(clr, key)
+}
+
+// VerifyKey verifies the strong name signature of the managed image read
+// by clr against the given public key. It returns nil if the signature is
+// valid. It returns the same errors as [Verify].
+func VerifyKey(clr *clrheader.Reader, key PublicKey) error {
+	header, err := clr.ReadHeader()
+	if err != nil {
+		return err
+	}
+	signature, err := ReadSignature(clr, header)
+	if err != nil {
+		return err
+	}
+	if !header.Flags.StrongNameSigned() {
+		return ErrDelaySigned
+	}
+	if !slices.ContainsFunc(signature, func(b byte) bool { return b != 0 }) {
+		return ErrPublicSigned
+	}
+	if size := key.Key.Size(); len(signature) != size {
+		return fmt.Errorf("the strong name signature is %d byte(s) long when the public key requires %d bytes", len(signature), size)
+	}
+
+	algorithm, err := key.Hash()
+	if err != nil {
+		return err
+	}
+	digest, err := Hash(clr.PE(), header.StrongNameSignature.Location, algorithm)
+	if err != nil {
+		return fmt.Errorf("failed to compute the strong name hash: %w", err)
+	}
+
+	// The signature is stored in little-endian byte order.
+	signature = slices.Clone(signature)
+	slices.Reverse(signature)
+	if err := rsa.VerifyPKCS1v15(key.Key, algorithm, digest, signature); err != nil {
+		if errors.Is(err, rsa.ErrVerification) {
+			return ErrInvalidSignature
+		}
+		return fmt.Errorf("failed to verify the strong name signature: %w", err)
+	}
+	return nil
+}
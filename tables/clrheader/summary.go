@@ -0,0 +1,143 @@
+package clrheader
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Kind identifies the kind of code contained in an image file.
+type Kind int
+
+// Kinds of image files.
+const (
+	KindNative     Kind = iota // The image does not have a CLR header
+	KindManaged                // The image contains only intermediate language code
+	KindMixedMode              // The image contains both managed and native code
+	KindReadyToRun             // The image contains intermediate language code that has been precompiled to native code
+)
+
+// String returns a string representation of the kind.
+func (kind Kind) String() string {
+	switch kind {
+	case KindNative:
+		return "Native"
+	case KindManaged:
+		return "Managed"
+	case KindMixedMode:
+		return "Mixed-Mode"
+	case KindReadyToRun:
+		return "ReadyToRun"
+	default:
+		return fmt.Sprintf("<unrecognized kind: %d>", int(kind))
+	}
+}
+
+// Summary holds the information that is reported by the CorFlags tool.
+type Summary struct {
+	Kind Kind
+
+	// Machine is the machine type of the image. For ReadyToRun images it
+	// is the machine targeted by the native code, which has been separated
+	// from the operating system that it targets.
+	Machine imagefile.Machine
+
+	// OperatingSystem is the operating system targeted by the native code
+	// of a ReadyToRun image.
+	OperatingSystem OperatingSystem
+
+	Format              imagefile.Format
+	MetadataVersion     string
+	MajorRuntimeVersion uint16
+	MinorRuntimeVersion uint16
+	Flags               Flags
+}
+
+// Summarize reads the CLR header of the image file read by pe and returns
+// a summary of it. Images without a CLR header are reported as native
+// images.
+func Summarize(pe *portableexecutable.Reader) (Summary, error) {
+	summary := Summary{
+		Kind:    KindNative,
+		Machine: pe.Machine(),
+		Format:  pe.Format(),
+	}
+
+	reader, err := NewReader(pe)
+	if err == ErrMissingCLRHeader {
+		return summary, nil
+	} else if err != nil {
+		return Summary{}, err
+	}
+
+	header, err := reader.ReadHeader()
+	if err != nil {
+		return Summary{}, err
+	}
+	summary.MajorRuntimeVersion = header.MajorRuntimeVersion
+	summary.MinorRuntimeVersion = header.MinorRuntimeVersion
+	summary.Flags = header.Flags
+	switch {
+	case header.Flags.ILOnly():
+		summary.Kind = KindManaged
+	case header.Flags&FlagILLibrary != 0 && !header.ManagedNativeHeader.IsZero():
+		// ReadyToRun compilers clear the ILONLY flag, but the image still
+		// holds the original intermediate language code.
+		summary.Kind = KindReadyToRun
+		if machine, os, ok := SplitMachine(summary.Machine); ok {
+			summary.Machine, summary.OperatingSystem = machine, os
+		}
+	default:
+		summary.Kind = KindMixedMode
+	}
+
+	if !header.Metadata.IsZero() {
+		version, err := reader.ReadMetadataVersion(header)
+		if err != nil {
+			return Summary{}, err
+		}
+		summary.MetadataVersion = version
+	}
+
+	return summary, nil
+}
+
+// Platform returns the platform targeted by the image, as it would be
+// described by the platform target of a .NET project. The operating system
+// targeted by ReadyToRun images for platforms other than Windows is
+// included in parentheses.
+func (summary Summary) Platform() string {
+	platform := summary.architecture()
+	if summary.OperatingSystem != OSWindows {
+		platform += " (" + summary.OperatingSystem.String() + ")"
+	}
+	return platform
+}
+
+// architecture returns the processor architecture targeted by the image.
+func (summary Summary) architecture() string {
+	if summary.Kind == KindNative {
+		return summary.Machine.String()
+	}
+	if summary.Format == imagefile.PE32 && summary.Machine == imagefile.MachineX86 && summary.Kind == KindManaged {
+		switch {
+		case summary.Flags.Requires32Bit():
+			return "x86"
+		case summary.Flags.Prefers32Bit():
+			return "AnyCPU (32-bit preferred)"
+		default:
+			return "AnyCPU"
+		}
+	}
+	switch summary.Machine {
+	case imagefile.MachineX86:
+		return "x86"
+	case imagefile.MachineAMD64:
+		return "x64"
+	case imagefile.MachineARM64:
+		return "ARM64"
+	default:
+		return summary.Machine.String()
+	}
+}
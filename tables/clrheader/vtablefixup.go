@@ -0,0 +1,76 @@
+package clrheader
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// VTableFixupType holds flags that describe the slots of a VTable fixup.
+type VTableFixupType uint16
+
+// VTable fixup types.
+const (
+	VTable32Bit                        VTableFixupType = 0x01 // COR_VTABLE_32BIT, The slots are 32 bits wide
+	VTable64Bit                        VTableFixupType = 0x02 // COR_VTABLE_64BIT, The slots are 64 bits wide
+	VTableFromUnmanaged                VTableFixupType = 0x04 // COR_VTABLE_FROM_UNMANAGED, The slots are called from unmanaged code
+	VTableFromUnmanagedRetainAppDomain VTableFixupType = 0x08 // COR_VTABLE_FROM_UNMANAGED_RETAIN_APPDOMAIN, The slots are called from unmanaged code without switching application domains
+	VTableCallMostDerived              VTableFixupType = 0x10 // COR_VTABLE_CALL_MOST_DERIVED, The slots call the most derived method
+)
+
+// SlotSize returns the size of each slot in bytes.
+func (t VTableFixupType) SlotSize() uint {
+	if t&VTable64Bit != 0 {
+		return 8
+	}
+	return 4
+}
+
+// String returns a string representation of the VTable fixup type.
+func (t VTableFixupType) String() string {
+	var names []string
+	if t&VTable32Bit != 0 {
+		names = append(names, "32BIT")
+	}
+	if t&VTable64Bit != 0 {
+		names = append(names, "64BIT")
+	}
+	if t&VTableFromUnmanaged != 0 {
+		names = append(names, "FROM_UNMANAGED")
+	}
+	if t&VTableFromUnmanagedRetainAppDomain != 0 {
+		names = append(names, "FROM_UNMANAGED_RETAIN_APPDOMAIN")
+	}
+	if t&VTableCallMostDerived != 0 {
+		names = append(names, "CALL_MOST_DERIVED")
+	}
+	if remaining := t &^ 0x1F; remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint16(remaining)))
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, " | ")
+}
+
+// vtableFixupSize is the size of an IMAGE_COR_VTABLEFIXUP structure.
+const vtableFixupSize = 8
+
+// VTableFixup describes a table of slots that the runtime fills in with
+// method addresses when it loads a mixed-mode image. Before the image is
+// loaded, each slot holds the metadata token of a method.
+type VTableFixup struct {
+	Address  imagefile.RelativeVirtualAddress
+	Count    uint16
+	Type     VTableFixupType
+	Location imagefile.FileRange
+}
+
+func parseVTableFixup(data []byte) (address imagefile.RelativeVirtualAddress, count uint16, t VTableFixupType) {
+	address = imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(data[0:4]))
+	count = binary.LittleEndian.Uint16(data[4:6])
+	t = VTableFixupType(binary.LittleEndian.Uint16(data[6:8]))
+	return
+}
@@ -0,0 +1,39 @@
+package debugdirectory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// codeViewRSDS is the signature of a CodeView debug entry in the PDB 7.0
+// format ("RSDS").
+const codeViewRSDS = 0x53445352
+
+// CodeView holds the content of a CodeView debug entry in the PDB 7.0
+// format, which identifies the program database for the image.
+type CodeView struct {
+	GUID [16]byte
+	Age  uint32
+	Path string
+}
+
+// ParseCodeView parses the given data as the content of a CodeView debug
+// entry. Only the PDB 7.0 ("RSDS") format is supported.
+func ParseCodeView(data []byte) (CodeView, error) {
+	if len(data) < 24 {
+		return CodeView{}, fmt.Errorf("the CodeView debug data has an invalid length of %d byte(s)", len(data))
+	}
+	if signature := binary.LittleEndian.Uint32(data[0:4]); signature != codeViewRSDS {
+		return CodeView{}, fmt.Errorf("the CodeView debug data has an unsupported signature of %x", signature)
+	}
+	var cv CodeView
+	copy(cv.GUID[:], data[4:20])
+	cv.Age = binary.LittleEndian.Uint32(data[20:24])
+	path := data[24:]
+	if end := bytes.IndexByte(path, 0); end >= 0 {
+		path = path[:end]
+	}
+	cv.Path = string(path)
+	return cv, nil
+}
@@ -0,0 +1,151 @@
+package debugdirectory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Type identifies the format of the debugging information referenced by a
+// debug directory entry.
+type Type uint32
+
+// Known debug types.
+//
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format#debug-type
+const (
+	TypeUnknown              Type = 0  // IMAGE_DEBUG_TYPE_UNKNOWN, An unknown value that is ignored by all tools
+	TypeCOFF                 Type = 1  // IMAGE_DEBUG_TYPE_COFF, COFF debug information
+	TypeCodeView             Type = 2  // IMAGE_DEBUG_TYPE_CODEVIEW, Visual C++ debug information
+	TypeFPO                  Type = 3  // IMAGE_DEBUG_TYPE_FPO, Frame pointer omission information
+	TypeMisc                 Type = 4  // IMAGE_DEBUG_TYPE_MISC, The location of a DBG file
+	TypeException            Type = 5  // IMAGE_DEBUG_TYPE_EXCEPTION, A copy of the .pdata section
+	TypeFixup                Type = 6  // IMAGE_DEBUG_TYPE_FIXUP, Reserved
+	TypeOMAPToSource         Type = 7  // IMAGE_DEBUG_TYPE_OMAP_TO_SRC, The mapping from an RVA in the image to an RVA in the source image
+	TypeOMAPFromSource       Type = 8  // IMAGE_DEBUG_TYPE_OMAP_FROM_SRC, The mapping from an RVA in the source image to an RVA in the image
+	TypeBorland              Type = 9  // IMAGE_DEBUG_TYPE_BORLAND, Reserved for Borland
+	TypeReserved10           Type = 10 // IMAGE_DEBUG_TYPE_RESERVED10, Reserved
+	TypeCLSID                Type = 11 // IMAGE_DEBUG_TYPE_CLSID, Reserved
+	TypeVCFeature            Type = 12 // IMAGE_DEBUG_TYPE_VC_FEATURE, Visual C++ feature usage counts
+	TypePOGO                 Type = 13 // IMAGE_DEBUG_TYPE_POGO, Profile guided optimization information
+	TypeILTCG                Type = 14 // IMAGE_DEBUG_TYPE_ILTCG, Incremental link-time code generation information
+	TypeMPX                  Type = 15 // IMAGE_DEBUG_TYPE_MPX, Intel memory protection extensions information
+	TypeRepro                Type = 16 // IMAGE_DEBUG_TYPE_REPRO, PE determinism or reproducibility
+	TypeEmbeddedPortablePDB  Type = 17 // IMAGE_DEBUG_TYPE_EMBEDDED_PORTABLE_PDB, An embedded portable PDB
+	TypeSPGO                 Type = 18 // IMAGE_DEBUG_TYPE_SPGO, Sample profile guided optimization information
+	TypePDBChecksum          Type = 19 // IMAGE_DEBUG_TYPE_PDBCHECKSUM, The checksum of a PDB file
+	TypeExDllCharacteristics Type = 20 // IMAGE_DEBUG_TYPE_EX_DLLCHARACTERISTICS, Extended DLL characteristics bits
+)
+
+// String returns a string representation of the debug type.
+func (t Type) String() string {
+	switch t {
+	case TypeUnknown:
+		return "Unknown"
+	case TypeCOFF:
+		return "COFF"
+	case TypeCodeView:
+		return "CodeView"
+	case TypeFPO:
+		return "FPO"
+	case TypeMisc:
+		return "Misc"
+	case TypeException:
+		return "Exception"
+	case TypeFixup:
+		return "Fixup"
+	case TypeOMAPToSource:
+		return "OMAP To Source"
+	case TypeOMAPFromSource:
+		return "OMAP From Source"
+	case TypeBorland:
+		return "Borland"
+	case TypeReserved10:
+		return "Reserved"
+	case TypeCLSID:
+		return "CLSID"
+	case TypeVCFeature:
+		return "VC Feature"
+	case TypePOGO:
+		return "POGO"
+	case TypeILTCG:
+		return "ILTCG"
+	case TypeMPX:
+		return "MPX"
+	case TypeRepro:
+		return "Repro"
+	case TypeEmbeddedPortablePDB:
+		return "Embedded Portable PDB"
+	case TypeSPGO:
+		return "SPGO"
+	case TypePDBChecksum:
+		return "PDB Checksum"
+	case TypeExDllCharacteristics:
+		return "Extended DLL Characteristics"
+	default:
+		return fmt.Sprintf("<unrecognized debug type: %d>", uint32(t))
+	}
+}
+
+// entrySize is the size of an IMAGE_DEBUG_DIRECTORY structure.
+const entrySize = 28
+
+// Entry is a debug directory entry, which describes a block of debugging
+// information within the image file.
+type Entry struct {
+	Characteristics uint32
+	TimeDateStamp   uint32
+	MajorVersion    uint16
+	MinorVersion    uint16
+	Type            Type
+
+	// Address is the relative virtual address of the debugging
+	// information when it is loaded. It is zero if the information is not
+	// mapped into memory.
+	Address imagefile.RelativeVirtualAddress
+
+	// Location is the file range of the debugging information.
+	Location imagefile.FileRange
+}
+
+// Time returns the time date stamp of the entry as a time value. Images
+// that are built reproducibly store a hash in this field instead.
+func (entry Entry) Time() time.Time {
+	return time.Unix(int64(entry.TimeDateStamp), 0).UTC()
+}
+
+type entryData []byte
+
+func (entry entryData) Characteristics() uint32 {
+	return binary.LittleEndian.Uint32(entry[0:4])
+}
+
+func (entry entryData) TimeDateStamp() uint32 {
+	return binary.LittleEndian.Uint32(entry[4:8])
+}
+
+func (entry entryData) MajorVersion() uint16 {
+	return binary.LittleEndian.Uint16(entry[8:10])
+}
+
+func (entry entryData) MinorVersion() uint16 {
+	return binary.LittleEndian.Uint16(entry[10:12])
+}
+
+func (entry entryData) Type() Type {
+	return Type(binary.LittleEndian.Uint32(entry[12:16]))
+}
+
+func (entry entryData) SizeOfData() uint32 {
+	return binary.LittleEndian.Uint32(entry[16:20])
+}
+
+func (entry entryData) AddressOfRawData() uint32 {
+	return binary.LittleEndian.Uint32(entry[20:24])
+}
+
+func (entry entryData) PointerToRawData() uint32 {
+	return binary.LittleEndian.Uint32(entry[24:28])
+}
@@ -0,0 +1,81 @@
+package debugdirectory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// POGOSignature identifies the kind of optimization that produced the
+// records of a POGO debug entry.
+type POGOSignature uint32
+
+// Known POGO signatures.
+const (
+	POGOLTCG         POGOSignature = 0x4C544347 // "LTCG", Link-time code generation
+	POGOInstrumented POGOSignature = 0x50474900 // "PGI\0", Profile guided optimization instrumentation
+	POGOOptimized    POGOSignature = 0x50474F00 // "PGO\0", Profile guided optimization
+	POGOUpdate       POGOSignature = 0x50475500 // "PGU\0", Profile guided optimization update
+)
+
+// String returns a string representation of the POGO signature.
+func (signature POGOSignature) String() string {
+	switch signature {
+	case POGOLTCG:
+		return "LTCG"
+	case POGOInstrumented:
+		return "PGI"
+	case POGOOptimized:
+		return "PGO"
+	case POGOUpdate:
+		return "PGU"
+	default:
+		return fmt.Sprintf("<unrecognized POGO signature: %x>", uint32(signature))
+	}
+}
+
+// POGO holds the records of a POGO debug entry, which are written by the
+// Microsoft linker when link-time code generation is used. Each record
+// describes a contribution to a section of the image.
+type POGO struct {
+	Signature POGOSignature
+	Records   []POGORecord
+}
+
+// POGORecord describes a range of the image that holds a particular kind
+// of code or data, such as ".text$mn" or ".rdata$zzzdbg".
+type POGORecord struct {
+	Range imagefile.RelativeVirtualAddressRange
+	Name  string
+}
+
+// ParsePOGO parses the given data as the content of a POGO debug entry.
+func ParsePOGO(data []byte) (POGO, error) {
+	if len(data) < 4 {
+		return POGO{}, fmt.Errorf("the POGO debug data has an invalid length of %d byte(s)", len(data))
+	}
+	pogo := POGO{Signature: POGOSignature(binary.LittleEndian.Uint32(data[0:4]))}
+	offset := 4
+	for offset+8 < len(data) {
+		address := binary.LittleEndian.Uint32(data[offset:])
+		size := binary.LittleEndian.Uint32(data[offset+4:])
+		name := data[offset+8:]
+		end := bytes.IndexByte(name, 0)
+		if end < 0 {
+			return pogo, fmt.Errorf("the POGO record at offset %d has an unterminated name", offset)
+		}
+		pogo.Records = append(pogo.Records, POGORecord{
+			Range: imagefile.RelativeVirtualAddressRange{
+				Start:  imagefile.RelativeVirtualAddress(address),
+				Length: uint(size),
+			},
+			Name: string(name[:end]),
+		})
+
+		// Each record is padded to a 4 byte boundary.
+		offset += (8 + end + 1 + 3) &^ 3
+	}
+	return pogo, nil
+}
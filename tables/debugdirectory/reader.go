@@ -0,0 +1,75 @@
+package debugdirectory
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+var (
+	// ErrMissingDebugDirectory is returned by [NewReader] if it is asked to
+	// operate on a portable executable that doesn't have a debug directory.
+	ErrMissingDebugDirectory = errors.New("the portable executable does not have a debug directory")
+)
+
+// Reader reads debug directory data for a portable executable image file
+// from an underlying [portableexecutable.Reader].
+type Reader struct {
+	pe       *portableexecutable.Reader
+	location imagefile.FileRange
+}
+
+// NewReader creates and initializes a new debug directory [Reader] that
+// reads from portable executable [portableexecutable.Reader] pe. It returns
+// [ErrMissingDebugDirectory] if the portable executable does not have a
+// debug directory.
+func NewReader(pe *portableexecutable.Reader) (*Reader, error) {
+	debug := pe.DataDirectories().Get(imagefile.DebugID)
+	if debug.IsZero() {
+		return nil, ErrMissingDebugDirectory
+	}
+
+	return &Reader{
+		pe:       pe,
+		location: debug.Location,
+	}, nil
+}
+
+// ReadTable reads all of the entries within the debug directory and
+// returns them.
+func (r *Reader) ReadTable() (Table, error) {
+	data, err := r.pe.ReadRange(r.location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the debug directory: %w", err)
+	}
+
+	count := len(data) / entrySize
+	table := make(Table, 0, count)
+	for i := range count {
+		entry := entryData(data[i*entrySize : (i+1)*entrySize])
+		table = append(table, Entry{
+			Characteristics: entry.Characteristics(),
+			TimeDateStamp:   entry.TimeDateStamp(),
+			MajorVersion:    entry.MajorVersion(),
+			MinorVersion:    entry.MinorVersion(),
+			Type:            entry.Type(),
+			Address:         imagefile.RelativeVirtualAddress(entry.AddressOfRawData()),
+			Location: imagefile.FileRange{
+				Start:  imagefile.FileOffset(entry.PointerToRawData()),
+				Length: uint(entry.SizeOfData()),
+			},
+		})
+	}
+	return table, nil
+}
+
+// ReadData returns the debugging information described by the given entry.
+func (r *Reader) ReadData(entry Entry) ([]byte, error) {
+	data, err := r.pe.ReadRange(entry.Location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s debug data at location %s: %w", entry.Type, entry.Location, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,15 @@
+package debugdirectory
+
+// Table holds the entries of a debug directory.
+type Table []Entry
+
+// Find returns the first entry in the table with the given type. It
+// returns false if the table doesn't have an entry of that type.
+func (table Table) Find(t Type) (Entry, bool) {
+	for _, entry := range table {
+		if entry.Type == t {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
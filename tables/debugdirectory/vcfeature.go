@@ -0,0 +1,33 @@
+package debugdirectory
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// VCFeature holds the content of a VC feature debug entry, which is written
+// by the Microsoft linker. It records the number of objects that were
+// produced by Visual C++ compilers along with the number that used various
+// security features.
+type VCFeature struct {
+	PreVC11 uint32 // The number of objects produced by compilers older than Visual C++ 11.0
+	CCPP    uint32 // The number of objects produced by C/C++ compilers
+	GS      uint32 // The number of objects compiled with /GS
+	SDL     uint32 // The number of objects compiled with /sdl
+	GuardN  uint32 // The number of objects compiled with /guard:cf
+}
+
+// ParseVCFeature parses the given data as the content of a VC feature
+// debug entry.
+func ParseVCFeature(data []byte) (VCFeature, error) {
+	if len(data) < 20 {
+		return VCFeature{}, fmt.Errorf("the VC feature debug data has an invalid length of %d byte(s)", len(data))
+	}
+	return VCFeature{
+		PreVC11: binary.LittleEndian.Uint32(data[0:4]),
+		CCPP:    binary.LittleEndian.Uint32(data[4:8]),
+		GS:      binary.LittleEndian.Uint32(data[8:12]),
+		SDL:     binary.LittleEndian.Uint32(data[12:16]),
+		GuardN:  binary.LittleEndian.Uint32(data[16:20]),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package versioninfo
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"iter"
@@ -10,10 +11,13 @@ import (
 
 const minNodeSize = 8
 
+const minANSINodeSize = 5
+
 // Node is a node within a version info tree.
 type Node struct {
 	data      []byte
-	keyLength uint16 // Does not include the key's 2-byte null terminator.
+	keyLength uint16 // Does not include the key's null terminator.
+	ansi      bool   // The node uses the 16-bit ANSI format.
 }
 
 // NewNode interprets the given data as a version info node and returns it.
@@ -24,6 +28,9 @@ func NewNode(data []byte) (Node, error) {
 		return Node{}, fmt.Errorf("the version info structure must be at least %d bytes", minNodeSize)
 	}
 	data = trim(data)
+	if len(data) < minNodeSize {
+		return Node{}, fmt.Errorf("the version info structure has a length of %d bytes when at least %d bytes are required", len(data), minNodeSize)
+	}
 	keyLength := utf16Null(data[6:])
 	if keyLength < 0 {
 		return Node{}, fmt.Errorf("the version info structure's key field is not null-terminated")
@@ -34,8 +41,37 @@ func NewNode(data []byte) (Node, error) {
 	}, nil
 }
 
+// NewANSINode interprets the given data as a version info node in the
+// 16-bit ANSI format used by NE executables and returns it. Nodes in this
+// format lack a type field and store their key and string values as
+// single-byte characters.
+//
+// If the data is too small or malformed it returns an error.
+func NewANSINode(data []byte) (Node, error) {
+	if len(data) < minANSINodeSize {
+		return Node{}, fmt.Errorf("the version info structure must be at least %d bytes", minANSINodeSize)
+	}
+	data = trim(data)
+	if len(data) < minANSINodeSize {
+		return Node{}, fmt.Errorf("the version info structure has a length of %d bytes when at least %d bytes are required", len(data), minANSINodeSize)
+	}
+	keyLength := bytes.IndexByte(data[4:], 0)
+	if keyLength < 0 {
+		return Node{}, fmt.Errorf("the version info structure's key field is not null-terminated")
+	}
+	return Node{
+		data:      data,
+		keyLength: uint16(keyLength),
+		ansi:      true,
+	}, nil
+}
+
 // Key returns the key that identifies the node.
 func (n Node) Key() string {
+	if n.ansi {
+		const start = 4
+		return bytesconv.DecodeANSI(n.data[start : start+n.keyLength])
+	}
 	const start = 6
 	return bytesconv.DecodeUTF16(n.data[start:start+n.keyLength], binary.LittleEndian)
 }
@@ -47,6 +83,15 @@ func (n Node) Value() Value {
 		return Value{}
 	}
 
+	if n.ansi {
+		start := min(int(n.valueStart()), len(n.data))
+		end := min(start+int(length), len(n.data))
+		return Value{
+			data: n.data[start:end],
+			ansi: true,
+		}
+	}
+
 	dataType := binary.LittleEndian.Uint16(n.data[4:6])
 
 	// If this is a string, the value length is probably in characters and not
@@ -71,9 +116,12 @@ func (n Node) Value() Value {
 
 // Children returns an iterator for the child nodes contained in n.
 func (n Node) Children() iter.Seq2[Node, error] {
-	valueStart := align(n.keyLength + 8)
+	valueStart := n.valueStart()
 	valueLength := binary.LittleEndian.Uint16(n.data[2:4])
-	dataType := binary.LittleEndian.Uint16(n.data[4:6])
+	var dataType uint16
+	if !n.ansi {
+		dataType = binary.LittleEndian.Uint16(n.data[4:6])
+	}
 
 	// If this is a string, the value length is probably in characters and not
 	// in bytes. This is due to an ancient bug that became a defacto standard.
@@ -86,23 +134,43 @@ func (n Node) Children() iter.Seq2[Node, error] {
 		valueLength *= 2
 	}
 
-	offset := align(valueStart + valueLength)
+	offset := int(align(valueStart + valueLength))
 
 	return func(yield func(Node, error) bool) {
-		for int(offset) < len(n.data) {
-			node, err := NewNode(n.data[offset:])
+		for offset < len(n.data) {
+			parse := NewNode
+			if n.ansi {
+				parse = NewANSINode
+			}
+			node, err := parse(n.data[offset:])
 			if !yield(node, err) {
 				return
 			}
 			if err != nil {
 				return
 			}
-			offset += uint16(len(node.data))
-			offset = align(offset)
+			if len(node.data) == 0 {
+				yield(Node{}, fmt.Errorf("the version info structure has a child with a length of zero at offset %d", offset))
+				return
+			}
+			offset = (offset + len(node.data) + 3) &^ 3
 		}
 	}
 }
 
+// valueStart returns the offset of the node's value, which follows the key
+// and its null terminator at the next 4 byte boundary.
+func (n Node) valueStart() uint16 {
+	if n.ansi {
+		// The offset of "5" is the sum of the node length, value length and
+		// the key's null terminator.
+		return align(n.keyLength + 5)
+	}
+	// The offset of "8" is the sum of the node length, value length, value
+	// type and the key's null terminator.
+	return align(n.keyLength + 8)
+}
+
 // trim reads node length information from the start of data and returns the
 // data truncated to that length.
 func trim(data []byte) []byte {
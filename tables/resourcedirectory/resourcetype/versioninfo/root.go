@@ -14,6 +14,15 @@ func NewRoot(data []byte) (Root, error) {
 	return Root(node), err
 }
 
+// NewANSIRoot interprets the given data as a version info tree in the 16-bit
+// ANSI format used by NE executables and returns the root for it.
+//
+// If the data is too small or malformed it returns an error.
+func NewANSIRoot(data []byte) (Root, error) {
+	node, err := NewANSINode(data)
+	return Root(node), err
+}
+
 // Key returns the key that identifies the root node.
 func (r Root) Key() string {
 	return Node(r).Key()
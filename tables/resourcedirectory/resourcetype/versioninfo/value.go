@@ -1,6 +1,7 @@
 package versioninfo
 
 import (
+	"bytes"
 	"encoding/binary"
 
 	"github.com/gentlemanautomaton/portableexecutable/internal/bytesconv"
@@ -14,6 +15,7 @@ const (
 type Value struct {
 	data     []byte
 	dataType uint16
+	ansi     bool
 }
 
 func (v Value) Data() []byte {
@@ -21,6 +23,15 @@ func (v Value) Data() []byte {
 }
 
 func (v Value) String() string {
+	if v.ansi {
+		// Values in the 16-bit format lack a type. Treat the value as a
+		// string if it is null-terminated and has no other nulls.
+		data, ok := bytes.CutSuffix(v.data, []byte{0})
+		if !ok || bytes.IndexByte(data, 0) >= 0 {
+			return ""
+		}
+		return bytesconv.DecodeANSI(data)
+	}
 	if len(v.data) < 2 {
 		return ""
 	}
@@ -0,0 +1,85 @@
+package te
+
+import (
+	"encoding/binary"
+
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// HeaderSize is the size of the TE header.
+const HeaderSize = 40
+
+// Signature is the first 2 bytes of a TE [Header], interpreted as a 16 bit
+// unsigned integer in little-endian encoding.
+//
+// In a valid [Header], the signature is expected to hold the well-known
+// value 0x5A56 ("VZ").
+type Signature uint16
+
+// Valid returns true if the signature contains the expected value 0x5A56.
+func (s Signature) Valid() bool {
+	return s == 0x5A56
+}
+
+// Header is the 40 byte header of a terse executable (TE) image. It takes
+// the place of the DOS header, PE header and optional header of the PE
+// image that the TE image was derived from, and is followed by the section
+// table. It is the EFI_TE_IMAGE_HEADER structure.
+type Header [HeaderSize]byte
+
+// Signature returns the two byte signature at the start of the header.
+func (header *Header) Signature() Signature {
+	return Signature(binary.LittleEndian.Uint16(header[0:2]))
+}
+
+// Machine returns the machine that the image is targeting.
+func (header *Header) Machine() imagefile.Machine {
+	return imagefile.Machine(binary.LittleEndian.Uint16(header[2:4]))
+}
+
+// NumberOfSections returns the number of sections in the section table.
+func (header *Header) NumberOfSections() uint8 {
+	return header[4]
+}
+
+// Subsystem returns the subsystem that is responsible for executing the
+// image.
+func (header *Header) Subsystem() imagefile.Subsystem {
+	return imagefile.Subsystem(header[5])
+}
+
+// StrippedSize returns the number of bytes that were removed from the start
+// of the original PE image, which is the size of the headers that precede
+// the section table.
+func (header *Header) StrippedSize() uint16 {
+	return binary.LittleEndian.Uint16(header[6:8])
+}
+
+// AddressOfEntryPoint returns the address of the entry point.
+func (header *Header) AddressOfEntryPoint() imagefile.RelativeVirtualAddress {
+	return imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(header[8:12]))
+}
+
+// BaseOfCode returns the address of the start of the code section.
+func (header *Header) BaseOfCode() imagefile.RelativeVirtualAddress {
+	return imagefile.RelativeVirtualAddress(binary.LittleEndian.Uint32(header[12:16]))
+}
+
+// ImageBase returns the preferred address of the image when it is loaded
+// into memory.
+func (header *Header) ImageBase() uint64 {
+	return binary.LittleEndian.Uint64(header[16:24])
+}
+
+// DataDirectories returns the data directory entries of the header. A TE
+// header only holds the base relocation table and debug entries, which
+// are returned at their usual indices.
+func (header *Header) DataDirectories() []imagefile.DataDirectory {
+	dirs := make([]imagefile.DataDirectory, imagefile.DebugID+1)
+	for i := range dirs {
+		dirs[i] = make(imagefile.DataDirectory, imagefile.DataDirectorySize)
+	}
+	copy(dirs[imagefile.BaseRelocationTableID], header[24:32])
+	copy(dirs[imagefile.DebugID], header[32:40])
+	return dirs
+}
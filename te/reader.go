@@ -0,0 +1,224 @@
+package te
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+)
+
+// Reader reads terse executable (TE) image file data from an underlying
+// [io.ReaderAt].
+//
+// A TE image is a PE image that has had its DOS header, PE header and
+// optional header replaced by a smaller TE header. The section headers and
+// data directories still hold the file offsets of the original PE image,
+// so the reader adjusts them by the number of bytes that were stripped.
+// The sections and data directories it returns use the same model as
+// [portableexecutable.Reader], with file offsets that are relative to the
+// start of the TE image.
+type Reader struct {
+	source io.ReaderAt
+
+	header      Header
+	sections    portableexecutable.SectionTable
+	directories portableexecutable.DataDirectoryTable
+}
+
+// NewReader creates and initializes a new TE image file [Reader] that reads
+// from source.
+//
+// It reads and validates the TE header and the section table. If the
+// validation fails it returns an error.
+func NewReader(source io.ReaderAt) (*Reader, error) {
+	reader := new(Reader)
+	if err := reader.init(source); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// Source returns the underlying source for the reader.
+func (r *Reader) Source() io.ReaderAt {
+	return r.source
+}
+
+// Header returns the TE header of the image file.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Machine returns the machine type that the image file is targeting.
+func (r *Reader) Machine() imagefile.Machine {
+	return r.header.Machine()
+}
+
+// Subsystem returns the subsystem that is responsible for executing the
+// image.
+func (r *Reader) Subsystem() imagefile.Subsystem {
+	return r.header.Subsystem()
+}
+
+// ImageBase returns the preferred address of the image when it is loaded
+// into memory.
+func (r *Reader) ImageBase() uint64 {
+	return r.header.ImageBase()
+}
+
+// EntryPoint returns the address of the entry point.
+func (r *Reader) EntryPoint() imagefile.RelativeVirtualAddress {
+	return r.header.AddressOfEntryPoint()
+}
+
+// StrippedSize returns the number of bytes that were removed from the start
+// of the original PE image.
+func (r *Reader) StrippedSize() uint16 {
+	return r.header.StrippedSize()
+}
+
+// Sections returns a table of sections that are present within the image
+// file. The file ranges of the sections are relative to the start of the
+// TE image.
+func (r *Reader) Sections() portableexecutable.SectionTable {
+	return r.sections
+}
+
+// DataDirectories returns the table of data directories for the image file.
+// Only the base relocation table and debug entries can be present in a TE
+// image. The file ranges of the entries are relative to the start of the
+// TE image.
+func (r *Reader) DataDirectories() portableexecutable.DataDirectoryTable {
+	return r.directories
+}
+
+// Translate maps the given relative virtual address to a file offset within
+// the TE image. Addresses that fall within the headers of the original PE
+// image are translated as well, as long as they weren't stripped.
+//
+// If the given address isn't present in the TE image, it returns false.
+func (r *Reader) Translate(address imagefile.RelativeVirtualAddress) (ok bool, offset imagefile.FileOffset) {
+	if ok, offset = r.sections.Translate(address); ok {
+		return
+	}
+	return r.adjust(imagefile.FileOffset(address))
+}
+
+// TranslateRange maps the given relative virtual address range to a file
+// range within the TE image. Ranges that fall within the headers of the
+// original PE image are translated as well, as long as they weren't
+// stripped.
+//
+// If the given range isn't present in the TE image, it returns false.
+func (r *Reader) TranslateRange(addressRange imagefile.RelativeVirtualAddressRange) (ok bool, fileRange imagefile.FileRange) {
+	if ok, fileRange = r.sections.TranslateRange(addressRange); ok {
+		return
+	}
+	ok, start := r.adjust(imagefile.FileOffset(addressRange.Start))
+	if !ok {
+		return false, imagefile.FileRange{}
+	}
+	return true, imagefile.FileRange{Start: start, Length: addressRange.Length}
+}
+
+// ReadRange reads data from the image file for the given file range.
+func (r *Reader) ReadRange(fileRange imagefile.FileRange) ([]byte, error) {
+	data := make([]byte, fileRange.Length)
+	_, err := r.source.ReadAt(data, int64(fileRange.Start))
+	return data, err
+}
+
+// adjust maps a file offset within the original PE image to a file offset
+// within the TE image. If the offset lies within the stripped headers it
+// returns false.
+func (r *Reader) adjust(offset imagefile.FileOffset) (ok bool, adjusted imagefile.FileOffset) {
+	stripped := imagefile.FileOffset(r.header.StrippedSize())
+	if offset < stripped {
+		return false, 0
+	}
+	return true, offset - stripped + HeaderSize
+}
+
+func (r *Reader) init(source io.ReaderAt) error {
+	r.source = source
+
+	// Read and verify the TE header.
+	if _, err := source.ReadAt(r.header[:], 0); err != nil {
+		return fmt.Errorf("failed to read the TE header: %w", err)
+	}
+	if signature := r.header.Signature(); !signature.Valid() {
+		return fmt.Errorf("the file does not have the expected TE header signature")
+	}
+	if r.header.StrippedSize() < HeaderSize {
+		return fmt.Errorf("the TE header has a stripped size of %d byte(s), which is less than the size of the TE header", r.header.StrippedSize())
+	}
+
+	// Read the section table, which immediately follows the TE header.
+	{
+		data, err := r.ReadRange(imagefile.FileRange{
+			Start:  HeaderSize,
+			Length: uint(r.header.NumberOfSections()) * imagefile.SectionHeaderSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read the section table of the TE image: %w", err)
+		}
+		count := len(data) / imagefile.SectionHeaderSize
+		r.sections = make(portableexecutable.SectionTable, 0, count)
+		for i := range count {
+			start := i * imagefile.SectionHeaderSize
+			end := start + imagefile.SectionHeaderSize
+			header := imagefile.SectionHeader(data[start:end])
+
+			// Sections without any initialized data don't have a file
+			// offset that needs to be adjusted.
+			location := imagefile.FileRange{
+				Start:  header.PointerToRawData(),
+				Length: header.SizeOfRawData(),
+			}
+			if location.Length > 0 {
+				ok, offset := r.adjust(location.Start)
+				if !ok {
+					return fmt.Errorf("section \"%s\" has a file offset (%d) that lies within the stripped headers of the TE image", header.Name(), location.Start)
+				}
+				location.Start = offset
+			}
+
+			r.sections = append(r.sections, portableexecutable.Section{
+				Name: header.Name(),
+				RelativeVirtualAddressRange: imagefile.RelativeVirtualAddressRange{
+					Start:  header.VirtualAddress(),
+					Length: header.VirtualSize(),
+				},
+				FileRange: location,
+			})
+		}
+	}
+
+	// Process the data directories in the TE header, translating their
+	// virtual addresses to file offsets within the TE image.
+	{
+		dataDirs := r.header.DataDirectories()
+		entries := make([]portableexecutable.DataDirectory, 0, len(dataDirs))
+		for i, entry := range dataDirs {
+			id := imagefile.DirectoryID(i)
+			if entry.IsZero() {
+				entries = append(entries, portableexecutable.DataDirectory{})
+				continue
+			}
+			addressRange := imagefile.RelativeVirtualAddressRange{
+				Start:  imagefile.RelativeVirtualAddress(entry.Address()),
+				Length: uint(entry.Size()),
+			}
+			ok, location := r.TranslateRange(addressRange)
+			if !ok {
+				return fmt.Errorf("data directory \"%s\" has a virtual address range (%s) that is not present within the TE image", id, addressRange)
+			}
+			entries = append(entries, portableexecutable.DataDirectory{
+				Location: location,
+			})
+		}
+		r.directories = portableexecutable.DataDirectoryTable(entries)
+	}
+
+	return nil
+}
@@ -0,0 +1,423 @@
+package toolchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/gentlemanautomaton/portableexecutable"
+	"github.com/gentlemanautomaton/portableexecutable/dos"
+	"github.com/gentlemanautomaton/portableexecutable/imagefile"
+	"github.com/gentlemanautomaton/portableexecutable/tables/debugdirectory"
+)
+
+// Family identifies a family of toolchains.
+type Family int
+
+// Toolchain families.
+const (
+	FamilyUnknown Family = iota
+	FamilyMSVC
+	FamilyMinGW
+	FamilyLLVM
+	FamilyGo
+	FamilyRust
+	FamilyDelphi
+)
+
+// String returns a string representation of the toolchain family.
+func (family Family) String() string {
+	switch family {
+	case FamilyUnknown:
+		return "Unknown"
+	case FamilyMSVC:
+		return "MSVC"
+	case FamilyMinGW:
+		return "MinGW"
+	case FamilyLLVM:
+		return "LLVM"
+	case FamilyGo:
+		return "Go"
+	case FamilyRust:
+		return "Rust"
+	case FamilyDelphi:
+		return "Delphi"
+	default:
+		return fmt.Sprintf("<unrecognized toolchain family: %d>", int(family))
+	}
+}
+
+// Toolchain describes a toolchain that appears to have been used to build
+// an image.
+type Toolchain struct {
+	// Family is the family the toolchain belongs to.
+	Family Family
+
+	// Name is a description of the toolchain and its version, such as
+	// "MSVC 19.38 / VS 2022 17.8" or "Go 1.22.1".
+	Name string
+
+	// Evidence describes the characteristics of the image that led to the
+	// toolchain being identified.
+	Evidence []string
+}
+
+// String returns a string representation of the toolchain.
+func (toolchain Toolchain) String() string {
+	return toolchain.Name
+}
+
+// Identify examines the image file read by pe and returns the toolchains
+// that appear to have been used to build it. More than one toolchain may be
+// returned when an image was built with a combination of them, such as a
+// Rust program that was linked by the Microsoft linker.
+//
+// Identification is based on heuristics that examine the rich header, the
+// linker version, the POGO and VC feature debug entries, section names and
+// well-known markers left behind by language runtimes. It returns an empty
+// slice if no toolchain could be identified.
+func Identify(pe *portableexecutable.Reader) ([]Toolchain, error) {
+	var facts imageFacts
+	if err := facts.collect(pe); err != nil {
+		return nil, err
+	}
+
+	var toolchains []Toolchain
+
+	// Language runtimes leave markers behind that identify them regardless
+	// of the linker that was used.
+	if facts.goVersion != "" || facts.hasSection(".symtab") && facts.goBuildID {
+		tc := Toolchain{Family: FamilyGo, Name: "Go"}
+		if facts.goVersion != "" {
+			tc.Name = "Go " + strings.TrimPrefix(facts.goVersion, "go")
+			tc.Evidence = append(tc.Evidence, fmt.Sprintf("build information records %s", facts.goVersion))
+		}
+		if facts.goBuildID {
+			tc.Evidence = append(tc.Evidence, "Go build ID found at the start of the .text section")
+		}
+		toolchains = append(toolchains, tc)
+	}
+	if facts.rust != "" {
+		toolchains = append(toolchains, Toolchain{
+			Family:   FamilyRust,
+			Name:     "Rust",
+			Evidence: []string{fmt.Sprintf("Rust runtime marker %q found in the .rdata section", facts.rust)},
+		})
+	}
+
+	switch {
+	case facts.hasRich:
+		toolchains = append(toolchains, facts.msvcFromRich())
+	case facts.isDelphi():
+		toolchains = append(toolchains, Toolchain{
+			Family:   FamilyDelphi,
+			Name:     "Delphi",
+			Evidence: facts.delphiEvidence(),
+		})
+	case facts.linkerMajor == 14 && facts.linkerMinor == 0:
+		tc := Toolchain{
+			Family:   FamilyLLVM,
+			Name:     "LLD",
+			Evidence: []string{"linker version 14.0 without a rich header"},
+		}
+		if facts.isMinGW() {
+			tc.Name = "LLVM MinGW (LLD)"
+			tc.Evidence = append(tc.Evidence, facts.mingwEvidence()...)
+		}
+		toolchains = append(toolchains, tc)
+	case facts.linkerMajor == 2 && facts.isMinGW():
+		toolchains = append(toolchains, Toolchain{
+			Family:   FamilyMinGW,
+			Name:     fmt.Sprintf("MinGW-w64 GCC (GNU ld 2.%d)", facts.linkerMinor),
+			Evidence: append([]string{fmt.Sprintf("GNU ld linker version 2.%d", facts.linkerMinor)}, facts.mingwEvidence()...),
+		})
+	case facts.linkerMajor == 14 && (facts.pogo || facts.vcFeature):
+		tc := Toolchain{Family: FamilyMSVC, Name: fmt.Sprintf("MSVC (link %d.%d)", facts.linkerMajor, facts.linkerMinor)}
+		if release, ok := LookupLinkerRelease(facts.linkerMajor, facts.linkerMinor); ok {
+			tc.Name = release.String()
+		}
+		tc.Evidence = append(tc.Evidence, fmt.Sprintf("linker version %d.%d", facts.linkerMajor, facts.linkerMinor))
+		tc.Evidence = append(tc.Evidence, facts.debugEvidence()...)
+		toolchains = append(toolchains, tc)
+	}
+
+	return toolchains, nil
+}
+
+// imageFacts holds characteristics of an image that are used to identify
+// the toolchain that produced it.
+type imageFacts struct {
+	hasRich     bool
+	rich        dos.RichHeader
+	linkerMajor uint8
+	linkerMinor uint8
+	sections    []string
+	pogo        bool
+	pogoKind    debugdirectory.POGOSignature
+	vcFeature   bool
+	features    debugdirectory.VCFeature
+	goVersion   string
+	goBuildID   bool
+	rust        string
+}
+
+// goBuildInfoMagic is the marker that precedes the build information
+// embedded in Go programs.
+var goBuildInfoMagic = []byte("\xff Go buildinf:")
+
+// goBuildIDPrefix is the prefix of the build ID that the Go linker places
+// at the start of the text section.
+var goBuildIDPrefix = []byte("\xff Go build ID: \"")
+
+// rustMarkers are strings that are embedded in programs that include the
+// Rust standard library.
+var rustMarkers = []string{
+	"/rustc/",
+	"\\rustc\\",
+	"library/std/src/",
+	"library\\std\\src\\",
+	"rust_panic",
+}
+
+// collect gathers facts about the image read by pe.
+func (facts *imageFacts) collect(pe *portableexecutable.Reader) error {
+	rich, err := pe.ReadRichHeader()
+	switch err {
+	case nil:
+		facts.hasRich = true
+		facts.rich = rich
+	case dos.ErrMissingRichHeader:
+	default:
+		return err
+	}
+
+	header := pe.OptionalHeader()
+	facts.linkerMajor = header.MajorLinkerVersion()
+	facts.linkerMinor = header.MinorLinkerVersion()
+
+	for _, section := range pe.Sections() {
+		name := string(section.Name)
+		if isReference, offset := section.Name.Reference(); isReference {
+			if resolved, err := pe.ReadString(offset); err == nil {
+				name = resolved
+			}
+		}
+		facts.sections = append(facts.sections, name)
+
+		switch name {
+		case ".text":
+			data, err := pe.ReadRange(firstBytes(section, 64))
+			if err != nil {
+				return fmt.Errorf("failed to read the start of the %s section: %w", name, err)
+			}
+			facts.goBuildID = bytes.HasPrefix(data, goBuildIDPrefix)
+		case ".rdata", ".data":
+			data, err := pe.ReadRange(section.FileRange)
+			if err != nil {
+				return fmt.Errorf("failed to read the %s section: %w", name, err)
+			}
+			if facts.goVersion == "" {
+				facts.goVersion = goVersion(data)
+			}
+			if facts.rust == "" && name == ".rdata" {
+				for _, marker := range rustMarkers {
+					if bytes.Contains(data, []byte(marker)) {
+						facts.rust = marker
+						break
+					}
+				}
+			}
+		}
+	}
+
+	debug, err := debugdirectory.NewReader(pe)
+	if err == debugdirectory.ErrMissingDebugDirectory {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	table, err := debug.ReadTable()
+	if err != nil {
+		return err
+	}
+	if entry, ok := table.Find(debugdirectory.TypePOGO); ok {
+		if data, err := debug.ReadData(entry); err == nil {
+			if pogo, err := debugdirectory.ParsePOGO(data); err == nil {
+				facts.pogo = true
+				facts.pogoKind = pogo.Signature
+			}
+		}
+	}
+	if entry, ok := table.Find(debugdirectory.TypeVCFeature); ok {
+		if data, err := debug.ReadData(entry); err == nil {
+			if features, err := debugdirectory.ParseVCFeature(data); err == nil {
+				facts.vcFeature = true
+				facts.features = features
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasSection returns true if the image has a section with the given name.
+func (facts *imageFacts) hasSection(name string) bool {
+	for _, section := range facts.sections {
+		if section == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isMinGW returns true if the image has sections that are characteristic
+// of the MinGW runtime.
+func (facts *imageFacts) isMinGW() bool {
+	return len(facts.mingwEvidence()) > 0
+}
+
+// mingwEvidence describes the sections of the image that are
+// characteristic of the MinGW runtime.
+func (facts *imageFacts) mingwEvidence() []string {
+	var evidence []string
+	for _, name := range facts.sections {
+		switch {
+		case name == ".CRT":
+			evidence = append(evidence, "MinGW .CRT section")
+		case strings.HasPrefix(name, ".debug_"):
+			evidence = append(evidence, fmt.Sprintf("DWARF %s section", name))
+		case name == ".eh_frame":
+			evidence = append(evidence, "GCC .eh_frame section")
+		}
+	}
+	return evidence
+}
+
+// isDelphi returns true if the image has sections that are characteristic
+// of the Delphi and C++Builder toolchains.
+func (facts *imageFacts) isDelphi() bool {
+	return len(facts.delphiEvidence()) > 0
+}
+
+// delphiEvidence describes the sections of the image that are
+// characteristic of the Delphi and C++Builder toolchains.
+func (facts *imageFacts) delphiEvidence() []string {
+	var evidence []string
+	if facts.hasSection(".itext") {
+		evidence = append(evidence, "Delphi .itext section")
+	}
+	if facts.hasSection("CODE") && facts.hasSection("DATA") {
+		evidence = append(evidence, "Borland CODE and DATA sections")
+	}
+	if len(evidence) > 0 {
+		evidence = append(evidence, fmt.Sprintf("linker version %d.%d", facts.linkerMajor, facts.linkerMinor))
+	}
+	return evidence
+}
+
+// debugEvidence describes the debug entries of the image that are written
+// by the Microsoft linker.
+func (facts *imageFacts) debugEvidence() []string {
+	var evidence []string
+	switch {
+	case facts.pogo && facts.pogoKind != 0:
+		evidence = append(evidence, fmt.Sprintf("POGO debug entry (%s)", facts.pogoKind))
+	case facts.pogo:
+		evidence = append(evidence, "POGO debug entry")
+	}
+	if facts.vcFeature {
+		evidence = append(evidence, fmt.Sprintf("VC feature debug entry (%d C/C++ objects)", facts.features.CCPP))
+	}
+	return evidence
+}
+
+// msvcFromRich identifies the Microsoft toolset from the rich header.
+//
+// The release is taken from the linker entry when present, because it
+// describes the toolset that produced the final image. Otherwise the most
+// recent compiler entry is used.
+func (facts *imageFacts) msvcFromRich() Toolchain {
+	tc := Toolchain{Family: FamilyMSVC, Name: "MSVC"}
+
+	var linker, compiler *dos.RichEntry
+	for i, entry := range facts.rich.Entries {
+		product, ok := LookupProduct(entry.ProductID)
+		if !ok {
+			continue
+		}
+		switch {
+		case product.Tool == ToolLinker:
+			if linker == nil || entry.ProductID > linker.ProductID || entry.ProductID == linker.ProductID && entry.Build > linker.Build {
+				linker = &facts.rich.Entries[i]
+			}
+		case product.Tool.IsCompiler():
+			if compiler == nil || entry.ProductID > compiler.ProductID || entry.ProductID == compiler.ProductID && entry.Build > compiler.Build {
+				compiler = &facts.rich.Entries[i]
+			}
+		}
+	}
+
+	for _, entry := range []*dos.RichEntry{linker, compiler} {
+		if entry == nil {
+			continue
+		}
+		product, _ := LookupProduct(entry.ProductID)
+		tc.Evidence = append(tc.Evidence, fmt.Sprintf("rich header entry %s build %d (%d objects)", product.Name, entry.Build, entry.Count))
+	}
+
+	chosen := linker
+	if chosen == nil {
+		chosen = compiler
+	}
+	if chosen != nil {
+		if release, ok := entryRelease(*chosen); ok {
+			tc.Name = release.String()
+		}
+	}
+
+	tc.Evidence = append(tc.Evidence, fmt.Sprintf("linker version %d.%d", facts.linkerMajor, facts.linkerMinor))
+	tc.Evidence = append(tc.Evidence, facts.debugEvidence()...)
+	return tc
+}
+
+// firstBytes returns the file range covering up to n bytes at the start of
+// the section.
+func firstBytes(section portableexecutable.Section, n uint) imagefile.FileRange {
+	return imagefile.FileRange{
+		Start:  section.FileRange.Start,
+		Length: min(section.FileRange.Length, n),
+	}
+}
+
+// goVersion looks for Go build information in data and returns the Go
+// version it records, such as "go1.22.1". It returns an empty string if
+// the build information is not found or is not in a supported format.
+func goVersion(data []byte) string {
+	// The build information starts with a 32 byte header that is aligned
+	// to a 16 byte boundary. Since Go 1.18 the version string follows the
+	// header as a varint-prefixed string when bit 2 of the flags is set.
+	for offset := 0; ; {
+		i := bytes.Index(data[offset:], goBuildInfoMagic)
+		if i < 0 {
+			return ""
+		}
+		start := offset + i
+		offset = start + 1
+		if start+32 > len(data) {
+			return ""
+		}
+		flags := data[start+15]
+		if flags&2 == 0 {
+			continue
+		}
+		length, n := binary.Uvarint(data[start+32:])
+		if n <= 0 || length > uint64(len(data)-start-32-n) {
+			continue
+		}
+		version := string(data[start+32+n : start+32+n+int(length)])
+		if strings.HasPrefix(version, "go") {
+			return version
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package toolchain
+
+import (
+	"fmt"
+
+	"github.com/gentlemanautomaton/portableexecutable/dos"
+)
+
+// Tool identifies the kind of tool that is described by a rich header
+// product.
+type Tool int
+
+// Kinds of tools.
+const (
+	ToolUnknown Tool = iota
+	ToolImport
+	ToolLinker
+	ToolOMFConverter
+	ToolResourceConverter
+	ToolProfileConverter
+	ToolExporter
+	ToolImportLibrarian
+	ToolAssembler
+	ToolILAssembler
+	ToolAliasObject
+	ToolResource
+	ToolVisualBasic
+	ToolCompilerBasic
+	ToolCompilerC
+	ToolCompilerCPP
+	ToolCompilerMSIL
+)
+
+// String returns a string representation of the tool.
+func (tool Tool) String() string {
+	switch tool {
+	case ToolUnknown:
+		return "Unknown"
+	case ToolImport:
+		return "Import"
+	case ToolLinker:
+		return "Linker"
+	case ToolOMFConverter:
+		return "OMF Converter"
+	case ToolResourceConverter:
+		return "Resource Converter"
+	case ToolProfileConverter:
+		return "Profile Converter"
+	case ToolExporter:
+		return "Exporter"
+	case ToolImportLibrarian:
+		return "Import Librarian"
+	case ToolAssembler:
+		return "Assembler"
+	case ToolILAssembler:
+		return "IL Assembler"
+	case ToolAliasObject:
+		return "Alias Object"
+	case ToolResource:
+		return "Resource"
+	case ToolVisualBasic:
+		return "Visual Basic"
+	case ToolCompilerBasic:
+		return "Basic Compiler"
+	case ToolCompilerC:
+		return "C Compiler"
+	case ToolCompilerCPP:
+		return "C++ Compiler"
+	case ToolCompilerMSIL:
+		return "MSIL Compiler"
+	default:
+		return fmt.Sprintf("<unrecognized tool: %d>", int(tool))
+	}
+}
+
+// IsCompiler returns true if the tool is a compiler.
+func (tool Tool) IsCompiler() bool {
+	switch tool {
+	case ToolCompilerBasic, ToolCompilerC, ToolCompilerCPP, ToolCompilerMSIL:
+		return true
+	default:
+		return false
+	}
+}
+
+// Product describes a Microsoft tool that can be recorded in a rich header.
+type Product struct {
+	// Name is the internal name of the product, such as "Utc1900_CPP".
+	Name string
+
+	// Tool is the kind of tool.
+	Tool Tool
+
+	// VisualStudio is the Visual Studio release that the product was
+	// shipped with.
+	VisualStudio string
+}
+
+// LookupProduct returns information about the product with the given rich
+// header product ID. It returns false if the product ID is not known.
+func LookupProduct(id uint16) (Product, bool) {
+	if int(id) >= len(products) {
+		return Product{}, false
+	}
+	return products[id], true
+}
+
+// Release describes a release of the Microsoft C/C++ toolset.
+type Release struct {
+	// Build is the first build number of the release.
+	Build uint16
+
+	// Compiler is the version of the compiler, such as "19.38".
+	Compiler string
+
+	// VisualStudio is the Visual Studio release that shipped the toolset,
+	// such as "VS 2022 17.8".
+	VisualStudio string
+}
+
+// String returns a string representation of the release.
+func (release Release) String() string {
+	return fmt.Sprintf("MSVC %s / %s", release.Compiler, release.VisualStudio)
+}
+
+// releases holds the known releases of the toolset that shipped with
+// Visual Studio 2015 and later, which share the same product IDs and are
+// told apart by their build numbers. They are sorted by build number.
+var releases = []Release{
+	{Build: 23026, Compiler: "19.00", VisualStudio: "VS 2015"},
+	{Build: 23506, Compiler: "19.00", VisualStudio: "VS 2015 Update 1"},
+	{Build: 23918, Compiler: "19.00", VisualStudio: "VS 2015 Update 2"},
+	{Build: 24210, Compiler: "19.00", VisualStudio: "VS 2015 Update 3"},
+	{Build: 25017, Compiler: "19.10", VisualStudio: "VS 2017 15.0"},
+	{Build: 25506, Compiler: "19.11", VisualStudio: "VS 2017 15.3"},
+	{Build: 25830, Compiler: "19.12", VisualStudio: "VS 2017 15.5"},
+	{Build: 26128, Compiler: "19.13", VisualStudio: "VS 2017 15.6"},
+	{Build: 26428, Compiler: "19.14", VisualStudio: "VS 2017 15.7"},
+	{Build: 26726, Compiler: "19.15", VisualStudio: "VS 2017 15.8"},
+	{Build: 27023, Compiler: "19.16", VisualStudio: "VS 2017 15.9"},
+	{Build: 27508, Compiler: "19.20", VisualStudio: "VS 2019 16.0"},
+	{Build: 27702, Compiler: "19.21", VisualStudio: "VS 2019 16.1"},
+	{Build: 27905, Compiler: "19.22", VisualStudio: "VS 2019 16.2"},
+	{Build: 28105, Compiler: "19.23", VisualStudio: "VS 2019 16.3"},
+	{Build: 28314, Compiler: "19.24", VisualStudio: "VS 2019 16.4"},
+	{Build: 28610, Compiler: "19.25", VisualStudio: "VS 2019 16.5"},
+	{Build: 28805, Compiler: "19.26", VisualStudio: "VS 2019 16.6"},
+	{Build: 29110, Compiler: "19.27", VisualStudio: "VS 2019 16.7"},
+	{Build: 29333, Compiler: "19.28", VisualStudio: "VS 2019 16.8"},
+	{Build: 29910, Compiler: "19.28", VisualStudio: "VS 2019 16.9"},
+	{Build: 30037, Compiler: "19.29", VisualStudio: "VS 2019 16.10"},
+	{Build: 30133, Compiler: "19.29", VisualStudio: "VS 2019 16.11"},
+	{Build: 30705, Compiler: "19.30", VisualStudio: "VS 2022 17.0"},
+	{Build: 31104, Compiler: "19.31", VisualStudio: "VS 2022 17.1"},
+	{Build: 31328, Compiler: "19.32", VisualStudio: "VS 2022 17.2"},
+	{Build: 31629, Compiler: "19.33", VisualStudio: "VS 2022 17.3"},
+	{Build: 31933, Compiler: "19.34", VisualStudio: "VS 2022 17.4"},
+	{Build: 32215, Compiler: "19.35", VisualStudio: "VS 2022 17.5"},
+	{Build: 32532, Compiler: "19.36", VisualStudio: "VS 2022 17.6"},
+	{Build: 32822, Compiler: "19.37", VisualStudio: "VS 2022 17.7"},
+	{Build: 33130, Compiler: "19.38", VisualStudio: "VS 2022 17.8"},
+	{Build: 33519, Compiler: "19.39", VisualStudio: "VS 2022 17.9"},
+	{Build: 33808, Compiler: "19.40", VisualStudio: "VS 2022 17.10"},
+	{Build: 34120, Compiler: "19.41", VisualStudio: "VS 2022 17.11"},
+	{Build: 34433, Compiler: "19.42", VisualStudio: "VS 2022 17.12"},
+	{Build: 34808, Compiler: "19.43", VisualStudio: "VS 2022 17.13"},
+	{Build: 35207, Compiler: "19.44", VisualStudio: "VS 2022 17.14"},
+}
+
+// legacyCompilers maps the Visual Studio releases that predate Visual Studio
+// 2015 to the versions of the compilers they shipped with.
+var legacyCompilers = map[string]string{
+	"VS 97":       "11.00",
+	"VS 6.0":      "12.00",
+	"VS 2002":     "13.00",
+	"VS 2003":     "13.10",
+	"VS 2005":     "14.00",
+	"VS 2008":     "15.00",
+	"VS 2010":     "16.00",
+	"VS 2010 SP1": "16.00",
+	"VS 2012":     "17.00",
+	"VS 2013":     "18.00",
+}
+
+// LookupRelease returns the release of the toolset that shipped with Visual
+// Studio 2015 or later that the given build number most likely belongs to.
+// It returns the latest known release whose first build is at or before
+// the given build. It returns false if the build precedes all known
+// releases.
+func LookupRelease(build uint16) (Release, bool) {
+	for i := len(releases) - 1; i >= 0; i-- {
+		if releases[i].Build <= build {
+			return releases[i], true
+		}
+	}
+	return Release{}, false
+}
+
+// LookupLinkerRelease returns the release of the toolset whose linker
+// writes the given version into the optional header of the images it
+// produces. It returns false if the version does not belong to a known
+// release of Visual Studio 2015 or later.
+func LookupLinkerRelease(major, minor uint8) (Release, bool) {
+	if major != 14 {
+		return Release{}, false
+	}
+	compiler := fmt.Sprintf("19.%02d", minor)
+	for i := len(releases) - 1; i >= 0; i-- {
+		if releases[i].Compiler == compiler {
+			return releases[i], true
+		}
+	}
+	return Release{}, false
+}
+
+// entryRelease returns the toolset release for the given rich header
+// entry.
+func entryRelease(entry dos.RichEntry) (Release, bool) {
+	product, ok := LookupProduct(entry.ProductID)
+	if !ok || product.VisualStudio == "" {
+		return Release{}, false
+	}
+	if compiler, ok := legacyCompilers[product.VisualStudio]; ok {
+		return Release{Build: entry.Build, Compiler: compiler, VisualStudio: product.VisualStudio}, true
+	}
+	return LookupRelease(entry.Build)
+}
@@ -0,0 +1,277 @@
+package toolchain
+
+// products holds information about the products that are known to appear
+// in rich headers, indexed by product ID.
+var products = [...]Product{
+	{Name: "Unknown", Tool: ToolUnknown, VisualStudio: ""},                                // 0x0000
+	{Name: "Import0", Tool: ToolImport, VisualStudio: ""},                                 // 0x0001
+	{Name: "Linker510", Tool: ToolLinker, VisualStudio: "VS 97"},                          // 0x0002
+	{Name: "Cvtomf510", Tool: ToolOMFConverter, VisualStudio: "VS 97"},                    // 0x0003
+	{Name: "Linker600", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0004
+	{Name: "Cvtomf600", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0005
+	{Name: "Cvtres500", Tool: ToolResourceConverter, VisualStudio: "VS 97"},               // 0x0006
+	{Name: "Utc11_Basic", Tool: ToolCompilerBasic, VisualStudio: "VS 97"},                 // 0x0007
+	{Name: "Utc11_C", Tool: ToolCompilerC, VisualStudio: "VS 97"},                         // 0x0008
+	{Name: "Utc12_Basic", Tool: ToolCompilerBasic, VisualStudio: "VS 6.0"},                // 0x0009
+	{Name: "Utc12_C", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                        // 0x000a
+	{Name: "Utc12_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},                    // 0x000b
+	{Name: "AliasObj60", Tool: ToolAliasObject, VisualStudio: "VS 6.0"},                   // 0x000c
+	{Name: "VisualBasic60", Tool: ToolVisualBasic, VisualStudio: "VS 6.0"},                // 0x000d
+	{Name: "Masm613", Tool: ToolAssembler, VisualStudio: "VS 6.0"},                        // 0x000e
+	{Name: "Masm710", Tool: ToolAssembler, VisualStudio: "VS 2003"},                       // 0x000f
+	{Name: "Linker511", Tool: ToolLinker, VisualStudio: "VS 97"},                          // 0x0010
+	{Name: "Cvtomf511", Tool: ToolOMFConverter, VisualStudio: "VS 97"},                    // 0x0011
+	{Name: "Masm614", Tool: ToolAssembler, VisualStudio: "VS 6.0"},                        // 0x0012
+	{Name: "Linker512", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0013
+	{Name: "Cvtomf512", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0014
+	{Name: "Utc12_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                    // 0x0015
+	{Name: "Utc12_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},                // 0x0016
+	{Name: "Utc12_C_Book", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                   // 0x0017
+	{Name: "Utc12_CPP_Book", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},               // 0x0018
+	{Name: "Implib700", Tool: ToolImportLibrarian, VisualStudio: "VS 2002"},               // 0x0019
+	{Name: "Cvtomf700", Tool: ToolOMFConverter, VisualStudio: "VS 2002"},                  // 0x001a
+	{Name: "Utc13_Basic", Tool: ToolCompilerBasic, VisualStudio: "VS 2002"},               // 0x001b
+	{Name: "Utc13_C", Tool: ToolCompilerC, VisualStudio: "VS 2002"},                       // 0x001c
+	{Name: "Utc13_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2002"},                   // 0x001d
+	{Name: "Linker610", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x001e
+	{Name: "Cvtomf610", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x001f
+	{Name: "Linker601", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0020
+	{Name: "Cvtomf601", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0021
+	{Name: "Utc12_1_Basic", Tool: ToolCompilerBasic, VisualStudio: "VS 6.0"},              // 0x0022
+	{Name: "Utc12_1_C", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                      // 0x0023
+	{Name: "Utc12_1_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},                  // 0x0024
+	{Name: "Linker620", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0025
+	{Name: "Cvtomf620", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0026
+	{Name: "AliasObj70", Tool: ToolAliasObject, VisualStudio: "VS 2002"},                  // 0x0027
+	{Name: "Linker621", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0028
+	{Name: "Cvtomf621", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0029
+	{Name: "Masm615", Tool: ToolAssembler, VisualStudio: "VS 6.0"},                        // 0x002a
+	{Name: "Utc13_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2002"},                  // 0x002b
+	{Name: "Utc13_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2002"},              // 0x002c
+	{Name: "Masm620", Tool: ToolAssembler, VisualStudio: "VS 6.0"},                        // 0x002d
+	{Name: "ILAsm100", Tool: ToolILAssembler, VisualStudio: "VS 2002"},                    // 0x002e
+	{Name: "Utc12_2_Basic", Tool: ToolCompilerBasic, VisualStudio: "VS 6.0"},              // 0x002f
+	{Name: "Utc12_2_C", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                      // 0x0030
+	{Name: "Utc12_2_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},                  // 0x0031
+	{Name: "Utc12_2_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                  // 0x0032
+	{Name: "Utc12_2_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},              // 0x0033
+	{Name: "Utc12_2_C_Book", Tool: ToolCompilerC, VisualStudio: "VS 6.0"},                 // 0x0034
+	{Name: "Utc12_2_CPP_Book", Tool: ToolCompilerCPP, VisualStudio: "VS 6.0"},             // 0x0035
+	{Name: "Implib622", Tool: ToolImportLibrarian, VisualStudio: "VS 6.0"},                // 0x0036
+	{Name: "Cvtomf622", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0037
+	{Name: "Cvtres501", Tool: ToolResourceConverter, VisualStudio: "VS 97"},               // 0x0038
+	{Name: "Utc13_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 2002"},                   // 0x0039
+	{Name: "Utc13_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 2002"},               // 0x003a
+	{Name: "Cvtpgd1300", Tool: ToolProfileConverter, VisualStudio: "VS 2002"},             // 0x003b
+	{Name: "Linker622", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x003c
+	{Name: "Linker700", Tool: ToolLinker, VisualStudio: "VS 2002"},                        // 0x003d
+	{Name: "Export622", Tool: ToolExporter, VisualStudio: "VS 6.0"},                       // 0x003e
+	{Name: "Export700", Tool: ToolExporter, VisualStudio: "VS 2002"},                      // 0x003f
+	{Name: "Masm700", Tool: ToolAssembler, VisualStudio: "VS 2002"},                       // 0x0040
+	{Name: "Utc13_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2002"},                // 0x0041
+	{Name: "Utc13_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2002"},            // 0x0042
+	{Name: "Utc13_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2002"},                // 0x0043
+	{Name: "Utc13_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2002"},            // 0x0044
+	{Name: "Cvtres700", Tool: ToolResourceConverter, VisualStudio: "VS 2002"},             // 0x0045
+	{Name: "Cvtres710p", Tool: ToolResourceConverter, VisualStudio: "VS 2003"},            // 0x0046
+	{Name: "Linker710p", Tool: ToolLinker, VisualStudio: "VS 2003"},                       // 0x0047
+	{Name: "Cvtomf710p", Tool: ToolOMFConverter, VisualStudio: "VS 2003"},                 // 0x0048
+	{Name: "Export710p", Tool: ToolExporter, VisualStudio: "VS 2003"},                     // 0x0049
+	{Name: "Implib710p", Tool: ToolImportLibrarian, VisualStudio: "VS 2003"},              // 0x004a
+	{Name: "Masm710p", Tool: ToolAssembler, VisualStudio: "VS 2003"},                      // 0x004b
+	{Name: "Utc1310p_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},                    // 0x004c
+	{Name: "Utc1310p_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},                // 0x004d
+	{Name: "Utc1310p_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 2003"},                // 0x004e
+	{Name: "Utc1310p_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},            // 0x004f
+	{Name: "Utc1310p_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},               // 0x0050
+	{Name: "Utc1310p_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},           // 0x0051
+	{Name: "Utc1310p_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},             // 0x0052
+	{Name: "Utc1310p_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},         // 0x0053
+	{Name: "Utc1310p_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},             // 0x0054
+	{Name: "Utc1310p_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},         // 0x0055
+	{Name: "Linker624", Tool: ToolLinker, VisualStudio: "VS 6.0"},                         // 0x0056
+	{Name: "Cvtomf624", Tool: ToolOMFConverter, VisualStudio: "VS 6.0"},                   // 0x0057
+	{Name: "Export624", Tool: ToolExporter, VisualStudio: "VS 6.0"},                       // 0x0058
+	{Name: "Implib624", Tool: ToolImportLibrarian, VisualStudio: "VS 6.0"},                // 0x0059
+	{Name: "Linker710", Tool: ToolLinker, VisualStudio: "VS 2003"},                        // 0x005a
+	{Name: "Cvtomf710", Tool: ToolOMFConverter, VisualStudio: "VS 2003"},                  // 0x005b
+	{Name: "Export710", Tool: ToolExporter, VisualStudio: "VS 2003"},                      // 0x005c
+	{Name: "Implib710", Tool: ToolImportLibrarian, VisualStudio: "VS 2003"},               // 0x005d
+	{Name: "Cvtres710", Tool: ToolResourceConverter, VisualStudio: "VS 2003"},             // 0x005e
+	{Name: "Utc1310_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},                     // 0x005f
+	{Name: "Utc1310_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},                 // 0x0060
+	{Name: "Utc1310_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 2003"},                 // 0x0061
+	{Name: "Utc1310_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},             // 0x0062
+	{Name: "Utc1310_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},                // 0x0063
+	{Name: "Utc1310_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},            // 0x0064
+	{Name: "Utc1310_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},              // 0x0065
+	{Name: "Utc1310_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},          // 0x0066
+	{Name: "Utc1310_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2003"},              // 0x0067
+	{Name: "Utc1310_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2003"},          // 0x0068
+	{Name: "AliasObj710", Tool: ToolAliasObject, VisualStudio: "VS 2003"},                 // 0x0069
+	{Name: "AliasObj710p", Tool: ToolAliasObject, VisualStudio: "VS 2003"},                // 0x006a
+	{Name: "Cvtpgd1310", Tool: ToolProfileConverter, VisualStudio: "VS 2003"},             // 0x006b
+	{Name: "Cvtpgd1310p", Tool: ToolProfileConverter, VisualStudio: "VS 2003"},            // 0x006c
+	{Name: "Utc1400_C", Tool: ToolCompilerC, VisualStudio: "VS 2005"},                     // 0x006d
+	{Name: "Utc1400_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},                 // 0x006e
+	{Name: "Utc1400_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 2005"},                 // 0x006f
+	{Name: "Utc1400_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},             // 0x0070
+	{Name: "Utc1400_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2005"},                // 0x0071
+	{Name: "Utc1400_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},            // 0x0072
+	{Name: "Utc1400_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2005"},              // 0x0073
+	{Name: "Utc1400_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},          // 0x0074
+	{Name: "Utc1400_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2005"},              // 0x0075
+	{Name: "Utc1400_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},          // 0x0076
+	{Name: "Cvtpgd1400", Tool: ToolProfileConverter, VisualStudio: "VS 2005"},             // 0x0077
+	{Name: "Linker800", Tool: ToolLinker, VisualStudio: "VS 2005"},                        // 0x0078
+	{Name: "Cvtomf800", Tool: ToolOMFConverter, VisualStudio: "VS 2005"},                  // 0x0079
+	{Name: "Export800", Tool: ToolExporter, VisualStudio: "VS 2005"},                      // 0x007a
+	{Name: "Implib800", Tool: ToolImportLibrarian, VisualStudio: "VS 2005"},               // 0x007b
+	{Name: "Cvtres800", Tool: ToolResourceConverter, VisualStudio: "VS 2005"},             // 0x007c
+	{Name: "Masm800", Tool: ToolAssembler, VisualStudio: "VS 2005"},                       // 0x007d
+	{Name: "AliasObj800", Tool: ToolAliasObject, VisualStudio: "VS 2005"},                 // 0x007e
+	{Name: "PhoenixPrerelease", Tool: ToolCompilerC, VisualStudio: "VS 2010"},             // 0x007f
+	{Name: "Utc1400_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2005"},              // 0x0080
+	{Name: "Utc1400_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2005"},          // 0x0081
+	{Name: "Utc1400_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2005"},          // 0x0082
+	{Name: "Utc1500_C", Tool: ToolCompilerC, VisualStudio: "VS 2008"},                     // 0x0083
+	{Name: "Utc1500_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},                 // 0x0084
+	{Name: "Utc1500_C_Std", Tool: ToolCompilerC, VisualStudio: "VS 2008"},                 // 0x0085
+	{Name: "Utc1500_CPP_Std", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},             // 0x0086
+	{Name: "Utc1500_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2008"},              // 0x0087
+	{Name: "Utc1500_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},          // 0x0088
+	{Name: "Utc1500_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2008"},                // 0x0089
+	{Name: "Utc1500_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},            // 0x008a
+	{Name: "Utc1500_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2008"},          // 0x008b
+	{Name: "Utc1500_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2008"},              // 0x008c
+	{Name: "Utc1500_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},          // 0x008d
+	{Name: "Utc1500_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2008"},              // 0x008e
+	{Name: "Utc1500_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2008"},          // 0x008f
+	{Name: "Cvtpgd1500", Tool: ToolProfileConverter, VisualStudio: "VS 2008"},             // 0x0090
+	{Name: "Linker900", Tool: ToolLinker, VisualStudio: "VS 2008"},                        // 0x0091
+	{Name: "Export900", Tool: ToolExporter, VisualStudio: "VS 2008"},                      // 0x0092
+	{Name: "Implib900", Tool: ToolImportLibrarian, VisualStudio: "VS 2008"},               // 0x0093
+	{Name: "Cvtres900", Tool: ToolResourceConverter, VisualStudio: "VS 2008"},             // 0x0094
+	{Name: "Masm900", Tool: ToolAssembler, VisualStudio: "VS 2008"},                       // 0x0095
+	{Name: "AliasObj900", Tool: ToolAliasObject, VisualStudio: "VS 2008"},                 // 0x0096
+	{Name: "Resource", Tool: ToolResource, VisualStudio: ""},                              // 0x0097
+	{Name: "AliasObj1000", Tool: ToolAliasObject, VisualStudio: "VS 2010"},                // 0x0098
+	{Name: "Cvtpgd1600", Tool: ToolProfileConverter, VisualStudio: "VS 2010"},             // 0x0099
+	{Name: "Cvtres1000", Tool: ToolResourceConverter, VisualStudio: "VS 2010"},            // 0x009a
+	{Name: "Export1000", Tool: ToolExporter, VisualStudio: "VS 2010"},                     // 0x009b
+	{Name: "Implib1000", Tool: ToolImportLibrarian, VisualStudio: "VS 2010"},              // 0x009c
+	{Name: "Linker1000", Tool: ToolLinker, VisualStudio: "VS 2010"},                       // 0x009d
+	{Name: "Masm1000", Tool: ToolAssembler, VisualStudio: "VS 2010"},                      // 0x009e
+	{Name: "Phx1600_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},                     // 0x009f
+	{Name: "Phx1600_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},                 // 0x00a0
+	{Name: "Phx1600_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00a1
+	{Name: "Phx1600_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00a2
+	{Name: "Phx1600_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},                // 0x00a3
+	{Name: "Phx1600_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},            // 0x00a4
+	{Name: "Phx1600_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2010"},          // 0x00a5
+	{Name: "Phx1600_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00a6
+	{Name: "Phx1600_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00a7
+	{Name: "Phx1600_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00a8
+	{Name: "Phx1600_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00a9
+	{Name: "Utc1600_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},                     // 0x00aa
+	{Name: "Utc1600_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},                 // 0x00ab
+	{Name: "Utc1600_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00ac
+	{Name: "Utc1600_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00ad
+	{Name: "Utc1600_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},                // 0x00ae
+	{Name: "Utc1600_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},            // 0x00af
+	{Name: "Utc1600_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2010"},          // 0x00b0
+	{Name: "Utc1600_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00b1
+	{Name: "Utc1600_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00b2
+	{Name: "Utc1600_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2010"},              // 0x00b3
+	{Name: "Utc1600_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010"},          // 0x00b4
+	{Name: "AliasObj1010", Tool: ToolAliasObject, VisualStudio: "VS 2010 SP1"},            // 0x00b5
+	{Name: "Cvtpgd1610", Tool: ToolProfileConverter, VisualStudio: "VS 2010 SP1"},         // 0x00b6
+	{Name: "Cvtres1010", Tool: ToolResourceConverter, VisualStudio: "VS 2010 SP1"},        // 0x00b7
+	{Name: "Export1010", Tool: ToolExporter, VisualStudio: "VS 2010 SP1"},                 // 0x00b8
+	{Name: "Implib1010", Tool: ToolImportLibrarian, VisualStudio: "VS 2010 SP1"},          // 0x00b9
+	{Name: "Linker1010", Tool: ToolLinker, VisualStudio: "VS 2010 SP1"},                   // 0x00ba
+	{Name: "Masm1010", Tool: ToolAssembler, VisualStudio: "VS 2010 SP1"},                  // 0x00bb
+	{Name: "Utc1610_C", Tool: ToolCompilerC, VisualStudio: "VS 2010 SP1"},                 // 0x00bc
+	{Name: "Utc1610_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010 SP1"},             // 0x00bd
+	{Name: "Utc1610_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2010 SP1"},          // 0x00be
+	{Name: "Utc1610_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010 SP1"},      // 0x00bf
+	{Name: "Utc1610_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2010 SP1"},            // 0x00c0
+	{Name: "Utc1610_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010 SP1"},        // 0x00c1
+	{Name: "Utc1610_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2010 SP1"},      // 0x00c2
+	{Name: "Utc1610_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2010 SP1"},          // 0x00c3
+	{Name: "Utc1610_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010 SP1"},      // 0x00c4
+	{Name: "Utc1610_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2010 SP1"},          // 0x00c5
+	{Name: "Utc1610_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2010 SP1"},      // 0x00c6
+	{Name: "AliasObj1100", Tool: ToolAliasObject, VisualStudio: "VS 2012"},                // 0x00c7
+	{Name: "Cvtpgd1700", Tool: ToolProfileConverter, VisualStudio: "VS 2012"},             // 0x00c8
+	{Name: "Cvtres1100", Tool: ToolResourceConverter, VisualStudio: "VS 2012"},            // 0x00c9
+	{Name: "Export1100", Tool: ToolExporter, VisualStudio: "VS 2012"},                     // 0x00ca
+	{Name: "Implib1100", Tool: ToolImportLibrarian, VisualStudio: "VS 2012"},              // 0x00cb
+	{Name: "Linker1100", Tool: ToolLinker, VisualStudio: "VS 2012"},                       // 0x00cc
+	{Name: "Masm1100", Tool: ToolAssembler, VisualStudio: "VS 2012"},                      // 0x00cd
+	{Name: "Utc1700_C", Tool: ToolCompilerC, VisualStudio: "VS 2012"},                     // 0x00ce
+	{Name: "Utc1700_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2012"},                 // 0x00cf
+	{Name: "Utc1700_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2012"},              // 0x00d0
+	{Name: "Utc1700_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2012"},          // 0x00d1
+	{Name: "Utc1700_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2012"},                // 0x00d2
+	{Name: "Utc1700_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2012"},            // 0x00d3
+	{Name: "Utc1700_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2012"},          // 0x00d4
+	{Name: "Utc1700_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2012"},              // 0x00d5
+	{Name: "Utc1700_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2012"},          // 0x00d6
+	{Name: "Utc1700_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2012"},              // 0x00d7
+	{Name: "Utc1700_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2012"},          // 0x00d8
+	{Name: "AliasObj1200", Tool: ToolAliasObject, VisualStudio: "VS 2013"},                // 0x00d9
+	{Name: "Cvtpgd1800", Tool: ToolProfileConverter, VisualStudio: "VS 2013"},             // 0x00da
+	{Name: "Cvtres1200", Tool: ToolResourceConverter, VisualStudio: "VS 2013"},            // 0x00db
+	{Name: "Export1200", Tool: ToolExporter, VisualStudio: "VS 2013"},                     // 0x00dc
+	{Name: "Implib1200", Tool: ToolImportLibrarian, VisualStudio: "VS 2013"},              // 0x00dd
+	{Name: "Linker1200", Tool: ToolLinker, VisualStudio: "VS 2013"},                       // 0x00de
+	{Name: "Masm1200", Tool: ToolAssembler, VisualStudio: "VS 2013"},                      // 0x00df
+	{Name: "Utc1800_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},                     // 0x00e0
+	{Name: "Utc1800_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},                 // 0x00e1
+	{Name: "Utc1800_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00e2
+	{Name: "Utc1800_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00e3
+	{Name: "Utc1800_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},                // 0x00e4
+	{Name: "Utc1800_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},            // 0x00e5
+	{Name: "Utc1800_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2013"},          // 0x00e6
+	{Name: "Utc1800_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00e7
+	{Name: "Utc1800_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00e8
+	{Name: "Utc1800_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00e9
+	{Name: "Utc1800_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00ea
+	{Name: "AliasObj1210", Tool: ToolAliasObject, VisualStudio: "VS 2013"},                // 0x00eb
+	{Name: "Cvtpgd1810", Tool: ToolProfileConverter, VisualStudio: "VS 2013"},             // 0x00ec
+	{Name: "Cvtres1210", Tool: ToolResourceConverter, VisualStudio: "VS 2013"},            // 0x00ed
+	{Name: "Export1210", Tool: ToolExporter, VisualStudio: "VS 2013"},                     // 0x00ee
+	{Name: "Implib1210", Tool: ToolImportLibrarian, VisualStudio: "VS 2013"},              // 0x00ef
+	{Name: "Linker1210", Tool: ToolLinker, VisualStudio: "VS 2013"},                       // 0x00f0
+	{Name: "Masm1210", Tool: ToolAssembler, VisualStudio: "VS 2013"},                      // 0x00f1
+	{Name: "Utc1810_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},                     // 0x00f2
+	{Name: "Utc1810_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},                 // 0x00f3
+	{Name: "Utc1810_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00f4
+	{Name: "Utc1810_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00f5
+	{Name: "Utc1810_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},                // 0x00f6
+	{Name: "Utc1810_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},            // 0x00f7
+	{Name: "Utc1810_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2013"},          // 0x00f8
+	{Name: "Utc1810_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00f9
+	{Name: "Utc1810_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00fa
+	{Name: "Utc1810_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2013"},              // 0x00fb
+	{Name: "Utc1810_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2013"},          // 0x00fc
+	{Name: "AliasObj1400", Tool: ToolAliasObject, VisualStudio: "VS 2015 or later"},       // 0x00fd
+	{Name: "Cvtpgd1900", Tool: ToolProfileConverter, VisualStudio: "VS 2015 or later"},    // 0x00fe
+	{Name: "Cvtres1400", Tool: ToolResourceConverter, VisualStudio: "VS 2015 or later"},   // 0x00ff
+	{Name: "Export1400", Tool: ToolExporter, VisualStudio: "VS 2015 or later"},            // 0x0100
+	{Name: "Implib1400", Tool: ToolImportLibrarian, VisualStudio: "VS 2015 or later"},     // 0x0101
+	{Name: "Linker1400", Tool: ToolLinker, VisualStudio: "VS 2015 or later"},              // 0x0102
+	{Name: "Masm1400", Tool: ToolAssembler, VisualStudio: "VS 2015 or later"},             // 0x0103
+	{Name: "Utc1900_C", Tool: ToolCompilerC, VisualStudio: "VS 2015 or later"},            // 0x0104
+	{Name: "Utc1900_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2015 or later"},        // 0x0105
+	{Name: "Utc1900_CVTCIL_C", Tool: ToolCompilerC, VisualStudio: "VS 2015 or later"},     // 0x0106
+	{Name: "Utc1900_CVTCIL_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2015 or later"}, // 0x0107
+	{Name: "Utc1900_LTCG_C", Tool: ToolCompilerC, VisualStudio: "VS 2015 or later"},       // 0x0108
+	{Name: "Utc1900_LTCG_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2015 or later"},   // 0x0109
+	{Name: "Utc1900_LTCG_MSIL", Tool: ToolCompilerMSIL, VisualStudio: "VS 2015 or later"}, // 0x010a
+	{Name: "Utc1900_POGO_I_C", Tool: ToolCompilerC, VisualStudio: "VS 2015 or later"},     // 0x010b
+	{Name: "Utc1900_POGO_I_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2015 or later"}, // 0x010c
+	{Name: "Utc1900_POGO_O_C", Tool: ToolCompilerC, VisualStudio: "VS 2015 or later"},     // 0x010d
+	{Name: "Utc1900_POGO_O_CPP", Tool: ToolCompilerCPP, VisualStudio: "VS 2015 or later"}, // 0x010e
+}